@@ -0,0 +1,53 @@
+package collections
+
+// Deque is a double-ended queue, which supports pushing and popping elements
+// at either end in amortized O(1) time. It is backed by a dynamic Ring (see
+// NewDynamicRing), so it grows as needed rather than becoming full.
+//
+// Like Ring, no synchronization is done.
+type Deque[T any] struct {
+	ring *Ring[T]
+}
+
+// NewDeque creates a new, empty Deque with space for initialSize elements
+// before it needs to grow.
+func NewDeque[T any](initialSize int) *Deque[T] {
+	return &Deque[T]{ring: NewDynamicRing[T](initialSize)}
+}
+
+// PushFront adds the element to the front of the deque.
+func (d *Deque[T]) PushFront(e T) {
+	d.ring.PushFront(e)
+}
+
+// PushBack adds the element to the back of the deque.
+func (d *Deque[T]) PushBack(e T) {
+	d.ring.PushBack(e)
+}
+
+// PopFront removes and returns the first element in the deque.
+// If the deque is empty, it returns false.
+func (d *Deque[T]) PopFront() (T, bool) {
+	return d.ring.PopFront()
+}
+
+// PopBack removes and returns the last element in the deque.
+// If the deque is empty, it returns false.
+func (d *Deque[T]) PopBack() (T, bool) {
+	return d.ring.PopBack()
+}
+
+// PeekFront returns the first element in the deque without removing it.
+func (d *Deque[T]) PeekFront() (T, bool) {
+	return d.ring.PeekFront()
+}
+
+// PeekBack returns the last element in the deque without removing it.
+func (d *Deque[T]) PeekBack() (T, bool) {
+	return d.ring.PeekBack()
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.ring.Len()
+}
@@ -0,0 +1,45 @@
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arg0net/collections"
+)
+
+func TestDeque(t *testing.T) {
+	d := collections.NewDeque[int](2)
+	_, ok := d.PopFront()
+	require.False(t, ok)
+	_, ok = d.PopBack()
+	require.False(t, ok)
+
+	// Grows past the initial size from both ends.
+	for i := 0; i < 5; i++ {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+	require.Equal(t, 10, d.Len())
+
+	el, ok := d.PeekFront()
+	require.True(t, ok)
+	require.Equal(t, -5, el)
+	el, ok = d.PeekBack()
+	require.True(t, ok)
+	require.Equal(t, 4, el)
+
+	for i := 5; i > 0; i-- {
+		el, ok := d.PopFront()
+		require.True(t, ok)
+		require.Equal(t, -i, el)
+	}
+	for i := 4; i >= 0; i-- {
+		el, ok := d.PopBack()
+		require.True(t, ok)
+		require.Equal(t, i, el)
+	}
+	require.Equal(t, 0, d.Len())
+	_, ok = d.PeekFront()
+	require.False(t, ok)
+}
@@ -0,0 +1,63 @@
+package collections
+
+import (
+	"sync"
+)
+
+// DoubleBuffer is a pair of fixed-size rings for producer/consumer handoff.
+// Producers write into the back buffer, while consumers read a consistent
+// snapshot from the front buffer. Swap exchanges the two buffers.
+//
+// DoubleBuffer is safe for concurrent use. Writers and readers use separate
+// locks, so readers do not block writers (and vice versa) except during Swap.
+type DoubleBuffer[T any] struct {
+	writeMu sync.Mutex   // protects back.
+	readMu  sync.RWMutex // protects front.
+	front   *Ring[T]
+	back    *Ring[T]
+}
+
+// NewDoubleBuffer creates a new DoubleBuffer, where each buffer holds up to
+// fixedSize elements.
+func NewDoubleBuffer[T any](fixedSize int) *DoubleBuffer[T] {
+	return &DoubleBuffer[T]{
+		front: NewRing[T](fixedSize),
+		back:  NewRing[T](fixedSize),
+	}
+}
+
+// Write adds the element to the back buffer. If the back buffer is full,
+// it returns false.
+func (d *DoubleBuffer[T]) Write(e T) bool {
+	d.writeMu.Lock()
+	defer d.writeMu.Unlock()
+	return d.back.PushBack(e)
+}
+
+// Swap exchanges the front and back buffers, and returns the number of
+// elements in the new front buffer. The new back buffer is reset, so that
+// producers start filling it from empty.
+//
+// Swap acquires both the write and read locks. Every Write which returned
+// before Swap was called is visible to Read calls made after Swap returns,
+// and no Write made after Swap returns is visible in the new front buffer.
+// A Read in progress completes against the old front buffer before the swap.
+func (d *DoubleBuffer[T]) Swap() int {
+	d.writeMu.Lock()
+	defer d.writeMu.Unlock()
+	d.readMu.Lock()
+	defer d.readMu.Unlock()
+
+	d.front, d.back = d.back, d.front
+	d.back.Reset()
+	return d.front.Len()
+}
+
+// Read calls fn with the front buffer. The front buffer will not change until
+// fn returns, and multiple readers may run concurrently.
+// The function must not modify the ring, nor retain it after returning.
+func (d *DoubleBuffer[T]) Read(fn func(front *Ring[T])) {
+	d.readMu.RLock()
+	defer d.readMu.RUnlock()
+	fn(d.front)
+}
@@ -0,0 +1,74 @@
+package collections_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arg0net/collections"
+)
+
+func TestDoubleBuffer(t *testing.T) {
+	d := collections.NewDoubleBuffer[int](3)
+
+	require.True(t, d.Write(1))
+	require.True(t, d.Write(2))
+	d.Read(func(front *collections.Ring[int]) {
+		require.Equal(t, 0, front.Len())
+	})
+
+	require.Equal(t, 2, d.Swap())
+	require.True(t, d.Write(3))
+	require.True(t, d.Write(4))
+	require.True(t, d.Write(5))
+	require.False(t, d.Write(6))
+	d.Read(func(front *collections.Ring[int]) {
+		require.Equal(t, []int{1, 2}, slices.Collect(front.All()))
+	})
+
+	require.Equal(t, 3, d.Swap())
+	d.Read(func(front *collections.Ring[int]) {
+		require.Equal(t, []int{3, 4, 5}, slices.Collect(front.All()))
+	})
+
+	require.Equal(t, 0, d.Swap())
+	d.Read(func(front *collections.Ring[int]) {
+		require.Equal(t, 0, front.Len())
+	})
+}
+
+func TestDoubleBuffer_Concurrent(t *testing.T) {
+	d := collections.NewDoubleBuffer[int](1000)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				d.Write(j)
+			}
+		}()
+	}
+
+	var total int
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	for finished := false; !finished; {
+		select {
+		case <-done:
+			finished = true
+		default:
+		}
+		total += d.Swap()
+		d.Read(func(front *collections.Ring[int]) {
+			require.LessOrEqual(t, front.Len(), 400)
+		})
+	}
+	require.Equal(t, 400, total)
+}
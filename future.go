@@ -11,9 +11,10 @@ import (
 // Future is a value that will be set at some point in the future.
 // It is similar to a StatefulNotifier, but can only be set once.
 type Future[T any] struct {
-	set   sync.Once
-	value T
-	done  chan struct{}
+	set     sync.Once
+	started sync.Once // for GetOrStart.
+	value   T
+	done    chan struct{}
 }
 
 // NewFuture creates a new Future.
@@ -29,15 +30,39 @@ func (f *Future[T]) Done() <-chan struct{} {
 }
 
 // Get blocks until the value is available or the context is cancelled.
+// If the context is cancelled, it returns the zero value and the context error.
 func (f *Future[T]) Get(ctx context.Context) (T, error) {
 	select {
 	case <-f.done:
 		return f.value, nil
 	case <-ctx.Done():
-		return f.value, ctx.Err()
+		// The value may be written concurrently by Set, so must not be read.
+		var zero T
+		return zero, ctx.Err()
 	}
 }
 
+// GetOrStart is like Get, but the first call starts computing the value by
+// calling start in a new goroutine, and setting the Future to the result.
+// Concurrent and subsequent calls wait for the same result, so start is called
+// at most once. If the Future has already been set, start is not called.
+//
+// Cancelling the context only stops the caller from waiting; the computation
+// continues in the background, and will be used by later calls.
+func (f *Future[T]) GetOrStart(ctx context.Context, start func() T) (T, error) {
+	f.started.Do(func() {
+		select {
+		case <-f.done:
+			return // already set.
+		default:
+		}
+		go func() {
+			f.Set(start())
+		}()
+	})
+	return f.Get(ctx)
+}
+
 // Set sets the value of the Future.
 // This unblocks any calls to Get.
 // It returns false if the Future has already been set.
@@ -51,6 +76,33 @@ func (f *Future[T]) Set(value T) bool {
 	return wasSet
 }
 
+// AndThen returns a Future which is set to the result of the future returned
+// by fn, once f has been set. This chains asynchronous steps without the caller
+// managing the intermediate futures.
+//
+// Futures do not hold errors, so if the context is cancelled before the chain
+// completes, or fn returns nil, the returned Future is never set. Callers
+// should wait on it with a context which is cancelled no later than ctx.
+func AndThen[T, U any](ctx context.Context, f *Future[T], fn func(T) *Future[U]) *Future[U] {
+	result := NewFuture[U]()
+	go func() {
+		v, err := f.Get(ctx)
+		if err != nil || ctx.Err() != nil {
+			return
+		}
+		next := fn(v)
+		if next == nil {
+			return
+		}
+		u, err := next.Get(ctx)
+		if err != nil {
+			return
+		}
+		result.Set(u)
+	}()
+	return result
+}
+
 // WatchFutures returns an iterator over the future results.
 // It will yield the index and value of the futures as they are set,
 // until the context is cancelled or all futures have been received.
@@ -92,3 +144,64 @@ func WatchFutures[T any](ctx context.Context, futures ...*Future[T]) iter.Seq2[i
 		}
 	}
 }
+
+// FirstOf blocks until any of the futures is set, or the context is cancelled.
+// It returns the value and index of the first future to be set. The remaining
+// futures are not waited on.
+// If the context is cancelled first, then the index is -1 and the context
+// error is returned.
+func FirstOf[T any](ctx context.Context, futures ...*Future[T]) (T, int, error) {
+	cases := make([]reflect.SelectCase, 0, len(futures)+1)
+	for _, f := range futures {
+		cases = append(cases, reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(f.Done()),
+		})
+	}
+	cases = append(cases, reflect.SelectCase{
+		Dir:  reflect.SelectRecv,
+		Chan: reflect.ValueOf(ctx.Done()),
+	})
+
+	chosen, _, _ := reflect.Select(cases)
+	if chosen == len(futures) {
+		var zero T
+		return zero, -1, ctx.Err()
+	}
+	return futures[chosen].value, chosen, nil
+}
+
+// FuturePool is a pool of reusable futures, to reduce allocations when many
+// short-lived futures are used, such as correlating requests and responses.
+// The zero value is ready to use.
+type FuturePool[T any] struct {
+	pool sync.Pool
+}
+
+// Get returns an unset Future from the pool, or a new Future if the pool is
+// empty.
+func (p *FuturePool[T]) Get() *Future[T] {
+	if f, ok := p.pool.Get().(*Future[T]); ok {
+		return f
+	}
+	return NewFuture[T]()
+}
+
+// Put resets the Future and returns it to the pool.
+//
+// Put must only be called once the Future is no longer in use: every caller
+// of Get or Done must have finished with it, and no further calls to Set may
+// be made. Otherwise, a caller may observe the Future being reused.
+func (p *FuturePool[T]) Put(f *Future[T]) {
+	select {
+	case <-f.done:
+		f.done = make(chan struct{})
+	default:
+		// never set, so the channel can be reused.
+	}
+	var zero T
+	f.value = zero
+	f.set = sync.Once{}
+	f.started = sync.Once{}
+	p.pool.Put(f)
+}
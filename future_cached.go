@@ -0,0 +1,62 @@
+package collections
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CachedFuture lazily computes a value and caches it for a fixed duration.
+// The first call to Get starts the computation, and concurrent callers wait
+// for the same result. Once the value has been cached for the TTL, the next
+// call to Get starts a new computation.
+type CachedFuture[T any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	compute func() T
+	current *Future[T] // nil if there is no valid or pending value.
+}
+
+// NewCachedFuture creates a new CachedFuture which calls compute to produce
+// the value, and caches the result for ttl.
+func NewCachedFuture[T any](ttl time.Duration, compute func() T) *CachedFuture[T] {
+	return &CachedFuture[T]{
+		ttl:     ttl,
+		compute: compute,
+	}
+}
+
+// Get returns the cached value, starting a new computation if there is no
+// cached value, and blocks until the value is available or the context is
+// cancelled. At most one computation runs at a time.
+//
+// The TTL starts once the computation finishes. A timer then resets the cache,
+// but only if it still holds the same result, so a stale timer never discards a
+// newer value. A Get which started before the reset may still return the
+// expired value.
+func (c *CachedFuture[T]) Get(ctx context.Context) (T, error) {
+	c.mu.Lock()
+	f := c.current
+	if f == nil {
+		f = NewFuture[T]()
+		c.current = f
+		go func() {
+			f.Set(c.compute())
+			time.AfterFunc(c.ttl, func() {
+				c.expire(f)
+			})
+		}()
+	}
+	c.mu.Unlock()
+
+	return f.Get(ctx)
+}
+
+// expire resets the cache, if it still holds the given future.
+func (c *CachedFuture[T]) expire(f *Future[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.current == f {
+		c.current = nil
+	}
+}
@@ -2,7 +2,11 @@ package collections_test
 
 import (
 	"context"
+	"errors"
+	"runtime"
 	"slices"
+	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -32,6 +36,257 @@ func TestFuture_GetCancelled(t *testing.T) {
 	require.ErrorIs(t, err, context.Canceled)
 }
 
+func TestFuture_GetCancelledDuringSet(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A cancelled Get must not read the value while Set writes it, which is
+	// detected by the race detector.
+	for range 100 {
+		f := collections.NewFuture[int]()
+		go f.Set(1)
+		v, err := f.Get(ctx)
+		if err != nil {
+			require.Zero(t, v)
+		}
+	}
+}
+
+func TestFuture_GetOrStart(t *testing.T) {
+	f := collections.NewFuture[int]()
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	start := func() int {
+		calls.Add(1)
+		<-release
+		return 42
+	}
+
+	type result struct {
+		v   int
+		err error
+	}
+	results := make(chan result, 10)
+	for i := 0; i < 10; i++ {
+		go func() {
+			v, err := f.GetOrStart(context.Background(), start)
+			results <- result{v, err}
+		}()
+	}
+
+	// A cancelled caller stops waiting without affecting the computation.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := f.GetOrStart(ctx, start)
+	require.ErrorIs(t, err, context.Canceled)
+
+	close(release)
+	for i := 0; i < 10; i++ {
+		r := <-results
+		require.NoError(t, r.err)
+		require.Equal(t, 42, r.v)
+	}
+	require.Equal(t, int32(1), calls.Load())
+
+	v, err := f.GetOrStart(context.Background(), start)
+	require.NoError(t, err)
+	require.Equal(t, 42, v)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestFuture_GetOrStartAlreadySet(t *testing.T) {
+	f := collections.NewFuture[int]()
+	f.Set(1)
+	var called atomic.Bool
+	v, err := f.GetOrStart(context.Background(), func() int {
+		called.Store(true)
+		return 2
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+	require.False(t, called.Load(), "start should not be called")
+}
+
+func TestFuture_GetNoLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	f := collections.NewFuture[int]()
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	const getters = 2000
+	errs := make(chan error, getters)
+	for i := 0; i < getters; i++ {
+		go func() {
+			if i%2 == 0 {
+				_, err := f.Get(cancelled)
+				errs <- err
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+			defer cancel()
+			_, err := f.Get(ctx)
+			errs <- err
+		}()
+	}
+	var canceled, expired int
+	for i := 0; i < getters; i++ {
+		switch err := <-errs; {
+		case errors.Is(err, context.Canceled):
+			canceled++
+		case errors.Is(err, context.DeadlineExceeded):
+			expired++
+		default:
+			require.Failf(t, "unexpected error", "%v", err)
+		}
+	}
+	require.Equal(t, getters/2, canceled)
+	require.Equal(t, getters/2, expired)
+
+	// WatchFutures returns without leaking when cancelled or stopped early.
+	for i := 0; i < 100; i++ {
+		for range collections.WatchFutures(cancelled, f) {
+			require.Fail(t, "unexpected value")
+		}
+	}
+	set := collections.NewFuture[int]()
+	set.Set(1)
+	for i := 0; i < 10; i++ {
+		for range collections.WatchFutures(context.Background(), f, set) {
+			break
+		}
+	}
+
+	// Poll directly, since require.Eventually starts its own goroutines.
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
+
+func TestFuturePool(t *testing.T) {
+	var p collections.FuturePool[int]
+
+	f := p.Get()
+	require.True(t, f.Set(1))
+	v, err := f.Get(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+	p.Put(f)
+
+	// Futures from the pool are always unset, whether or not reused.
+	for i := 0; i < 10; i++ {
+		f = p.Get()
+		select {
+		case <-f.Done():
+			require.Fail(t, "future from the pool is already set")
+		default:
+		}
+		require.True(t, f.Set(i))
+		v, err = f.Get(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, i, v)
+		p.Put(f)
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	f := collections.NewFuture[int]()
+	double := func(v int) *collections.Future[string] {
+		next := collections.NewFuture[string]()
+		go next.Set(strconv.Itoa(v * 2))
+		return next
+	}
+	result := collections.AndThen(ctx, f, double)
+
+	f.Set(21)
+	v, err := result.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "42", v)
+}
+
+func TestAndThen_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	f := collections.NewFuture[int]()
+	var called atomic.Bool
+	result := collections.AndThen(ctx, f, func(v int) *collections.Future[int] {
+		called.Store(true)
+		return collections.NewFuture[int]()
+	})
+	cancel()
+	_, err := result.Get(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	f.Set(1)
+	time.Sleep(10 * time.Millisecond)
+	require.False(t, called.Load(), "fn should not be called")
+	select {
+	case <-result.Done():
+		require.Fail(t, "result should not be set")
+	default:
+	}
+}
+
+func TestAndThen_Nil(t *testing.T) {
+	f := collections.NewFuture[int]()
+	called := make(chan struct{})
+	result := collections.AndThen(context.Background(), f, func(v int) *collections.Future[int] {
+		close(called)
+		return nil
+	})
+	f.Set(1)
+	<-called
+
+	// A nil future leaves the result unset, rather than panicking.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := result.Get(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestCachedFuture(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	c := collections.NewCachedFuture(50*time.Millisecond, func() int {
+		<-release
+		return int(calls.Add(1))
+	})
+	ctx := context.Background()
+
+	// Concurrent getters share a single computation.
+	values := make(chan int, 10)
+	for i := 0; i < 10; i++ {
+		go func() {
+			v, err := c.Get(ctx)
+			if err != nil {
+				v = -1
+			}
+			values <- v
+		}()
+	}
+	close(release)
+	for i := 0; i < 10; i++ {
+		require.Equal(t, 1, <-values)
+	}
+
+	v, err := c.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	// After the TTL, the value is recomputed once.
+	require.Eventually(t, func() bool {
+		v, err := c.Get(ctx)
+		return err == nil && v == 2
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, int32(2), calls.Load())
+}
+
 func TestWaitFutures(t *testing.T) {
 	f1 := collections.NewFuture[int]()
 	f2 := collections.NewFuture[int]()
@@ -63,3 +318,32 @@ func TestWaitFutures(t *testing.T) {
 	slices.Sort(results)
 	require.Equal(t, []int{1, 2, 3}, results)
 }
+
+func TestFirstOf(t *testing.T) {
+	f1 := collections.NewFuture[int]()
+	f2 := collections.NewFuture[int]()
+	f3 := collections.NewFuture[int]()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		f2.Set(2)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	v, idx, err := collections.FirstOf(ctx, f1, f2, f3)
+	require.NoError(t, err)
+	require.Equal(t, 2, v)
+	require.Equal(t, 1, idx)
+}
+
+func TestFirstOf_Cancelled(t *testing.T) {
+	f1 := collections.NewFuture[int]()
+	f2 := collections.NewFuture[int]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, idx, err := collections.FirstOf(ctx, f1, f2)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, -1, idx)
+}
@@ -0,0 +1,108 @@
+package collections
+
+import (
+	"sync"
+)
+
+// LatestPerKey is a publish/subscribe channel which coalesces updates by key.
+// It is intended for state synchronization, where a subscriber only needs the
+// most recent value for each key rather than the full history of updates.
+//
+// If a subscriber falls behind, then multiple updates for the same key are
+// collapsed into the latest value. Keys are delivered in the order that they
+// were first updated since the subscriber last caught up.
+//
+// Like Channel, values are not persisted. A subscriber only receives values
+// published after the subscription is created.
+type LatestPerKey[K comparable, T any] struct {
+	mu   sync.Mutex
+	subs map[*latestSubscriber[K, T]]struct{}
+}
+
+type latestSubscriber[K comparable, T any] struct {
+	keys   []K           // pending keys, in order of first update.
+	values map[K]T       // latest pending value for each key.
+	wake   chan struct{} // signalled when there are pending values.
+}
+
+// Publish a new value for the given key to all subscribers.
+// Any pending value for the same key which has not yet been delivered to a
+// subscriber is replaced.
+func (l *LatestPerKey[K, T]) Publish(key K, value T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for sub := range l.subs {
+		if _, ok := sub.values[key]; !ok {
+			sub.keys = append(sub.keys, key)
+		}
+		sub.values[key] = value
+		select {
+		case sub.wake <- struct{}{}:
+		default: // already signalled.
+		}
+	}
+}
+
+// Subscribe calls fn in the background with the latest value for each updated
+// key. The subscription is setup before the function returns, so it is safe to
+// publish values immediately after calling Subscribe.
+// The subscription will run until it is canceled.
+func (l *LatestPerKey[K, T]) Subscribe(fn func(K, T)) *Subscription[T] {
+	sub := &latestSubscriber[K, T]{
+		values: make(map[K]T),
+		wake:   make(chan struct{}, 1),
+	}
+
+	l.mu.Lock()
+	if l.subs == nil {
+		l.subs = make(map[*latestSubscriber[K, T]]struct{})
+	}
+	l.subs[sub] = struct{}{}
+	l.mu.Unlock()
+
+	s := &Subscription[T]{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go l.loop(s, sub, fn)
+	return s
+}
+
+// take removes and returns the pending updates for the subscriber.
+func (l *LatestPerKey[K, T]) take(sub *latestSubscriber[K, T]) ([]K, map[K]T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	keys, values := sub.keys, sub.values
+	sub.keys = nil
+	sub.values = make(map[K]T, len(values))
+	return keys, values
+}
+
+func (l *LatestPerKey[K, T]) loop(s *Subscription[T], sub *latestSubscriber[K, T], fn func(K, T)) {
+	defer close(s.done)
+	defer func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.subs, sub)
+	}()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+
+		case <-sub.wake:
+			keys, values := l.take(sub)
+			for _, k := range keys {
+				select {
+				case <-s.stop:
+					return
+				default:
+				}
+				fn(k, values[k])
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package collections_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arg0net/collections"
+)
+
+func TestLatestPerKey(t *testing.T) {
+	var l collections.LatestPerKey[string, int]
+
+	type update struct {
+		key   string
+		value int
+	}
+	var mu sync.Mutex
+	var updates []update
+	block := make(chan struct{})
+	sub := l.Subscribe(func(k string, v int) {
+		<-block
+		mu.Lock()
+		defer mu.Unlock()
+		updates = append(updates, update{k, v})
+	})
+	defer sub.Cancel()
+
+	// The first update is taken by the subscriber, which then blocks.
+	l.Publish("a", 0)
+	time.Sleep(10 * time.Millisecond)
+
+	// While the subscriber is behind, updates are collapsed per key.
+	for i := 1; i <= 100; i++ {
+		l.Publish("b", i)
+		l.Publish("a", i)
+	}
+	close(block)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(updates) == 3
+	}, 2*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []update{{"a", 0}, {"b", 100}, {"a", 100}}, updates)
+}
+
+func TestLatestPerKey_Cancel(t *testing.T) {
+	var l collections.LatestPerKey[int, int]
+
+	received := make(chan int, 10)
+	sub := l.Subscribe(func(_ int, v int) {
+		received <- v
+	})
+
+	l.Publish(1, 1)
+	require.Equal(t, 1, <-received)
+
+	sub.Cancel()
+	select {
+	case <-time.After(2 * time.Second):
+		require.Fail(t, "timeout")
+	case <-sub.Done():
+	}
+
+	l.Publish(1, 2)
+	time.Sleep(10 * time.Millisecond)
+	require.Empty(t, received)
+}
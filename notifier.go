@@ -9,9 +9,13 @@ import (
 // StatefulNotifier holds a value and notifies listeners when the value is updated.
 // Unlike a Channel, it does not persist values, so a listener (calling Get)
 // may not see all updates if multiple updates occur between calls to Get.
+//
+// The zero value is ready to use, and holds the zero value of T until a value
+// is stored. See LoadOrStore for lazily initializing the value.
 type StatefulNotifier[T any] struct {
 	mu      sync.Mutex
 	value   T
+	set     bool // whether a value has been stored, for LoadOrStore.
 	updated chan struct{}
 }
 
@@ -19,6 +23,7 @@ type StatefulNotifier[T any] struct {
 func NewStatefulNotifier[T any](initial T) *StatefulNotifier[T] {
 	return &StatefulNotifier[T]{
 		value: initial,
+		set:   true,
 	}
 }
 
@@ -28,12 +33,32 @@ func (n *StatefulNotifier[T]) Store(value T) {
 	defer n.mu.Unlock()
 
 	n.value = value
+	n.set = true
 	if n.updated != nil {
 		close(n.updated)
 		n.updated = nil
 	}
 }
 
+// Swap stores the new value, unblocking any listeners, and returns the
+// previous value. The exchange is atomic, so when there are concurrent calls to
+// Swap, each stored value is returned as the previous value exactly once.
+// This allows the caller to finalize the previous value, such as closing a
+// resource which has been replaced.
+func (n *StatefulNotifier[T]) Swap(value T) T {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	old := n.value
+	n.value = value
+	n.set = true
+	if n.updated != nil {
+		close(n.updated)
+		n.updated = nil
+	}
+	return old
+}
+
 // Load returns the current value, along with a channel that will unblock
 // when the value is updated.
 func (n *StatefulNotifier[T]) Load() (T, <-chan struct{}) {
@@ -45,6 +70,30 @@ func (n *StatefulNotifier[T]) Load() (T, <-chan struct{}) {
 	return n.value, n.updated
 }
 
+// LoadOrStore returns the current value if a value has been stored. Otherwise,
+// it stores the result of fn and unblocks any listeners, then returns the new
+// value. Concurrent callers call fn at most once.
+//
+// A value is considered stored once any of NewStatefulNotifier, Store, Swap,
+// Update or LoadOrStore has been called, even if the value is the zero value,
+// so this is only useful with a zero value StatefulNotifier.
+// Like Update, fn is called with the lock held.
+func (n *StatefulNotifier[T]) LoadOrStore(fn func() T) T {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.set {
+		return n.value
+	}
+	n.value = fn()
+	n.set = true
+	if n.updated != nil {
+		close(n.updated)
+		n.updated = nil
+	}
+	return n.value
+}
+
 // Update will atomically provide the current value to the update function
 // and store the result of the function.
 // Note that this will call the user's function with a lock held, so
@@ -54,6 +103,7 @@ func (n *StatefulNotifier[T]) Update(fn func(T) T) T {
 	defer n.mu.Unlock()
 
 	n.value = fn(n.value)
+	n.set = true
 	if n.updated != nil {
 		close(n.updated)
 		n.updated = nil
@@ -104,3 +154,58 @@ func (n *StatefulNotifier[T]) Watch(ctx context.Context) iter.Seq[T] {
 		}
 	}
 }
+
+// WatchUpdates is like Watch, but only yields values stored after WatchUpdates
+// is called, skipping the current value.
+// The subscription is setup before the function returns, so an update which
+// occurs after WatchUpdates returns, but before iteration begins, is not missed.
+func (n *StatefulNotifier[T]) WatchUpdates(ctx context.Context) iter.Seq[T] {
+	_, ch := n.Load()
+	return func(yield func(T) bool) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ch:
+			}
+
+			var v T
+			v, ch = n.Load()
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// WatchInto starts a goroutine which sends the current value and any updates
+// to the out channel, until the context is cancelled. It returns immediately.
+// This is an alternative to Watch for use with select-based event loops.
+//
+// Like Watch, updates may be missed if multiple updates occur quickly. If the
+// receiver is slow (or out is unbuffered and nobody is receiving), then a
+// pending value which has not yet been sent is replaced by the latest value,
+// so the receiver always skips ahead to the latest state.
+// The out channel is never closed.
+func (n *StatefulNotifier[T]) WatchInto(ctx context.Context, out chan<- T) {
+	v, ch := n.Load()
+	go func() {
+		pending := true
+		for {
+			var send chan<- T // nil, unless there is a pending value.
+			if pending {
+				send = out
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case send <- v:
+				pending = false
+			case <-ch:
+				v, ch = n.Load()
+				pending = true
+			}
+		}
+	}()
+}
@@ -56,6 +56,70 @@ func TestNotifierUpdate(t *testing.T) {
 	require.Equal(t, 10, v)
 }
 
+func TestNotifierSwap(t *testing.T) {
+	sn := collections.NewStatefulNotifier(0)
+	_, ch := sn.Load()
+	require.Equal(t, 0, sn.Swap(1))
+	<-ch
+
+	// With concurrent swappers, every value is handed off exactly once.
+	const swappers, swaps = 8, 1000
+	var mu sync.Mutex
+	seen := make(map[int]int)
+	var wg sync.WaitGroup
+	for i := 0; i < swappers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < swaps; j++ {
+				old := sn.Swap(2 + i*swaps + j)
+				mu.Lock()
+				seen[old]++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	last, _ := sn.Load()
+	seen[last]++
+	require.Len(t, seen, swappers*swaps+1)
+	for v, count := range seen {
+		require.Equal(t, 1, count, "value %d", v)
+	}
+}
+
+func TestNotifierLoadOrStore(t *testing.T) {
+	var sn collections.StatefulNotifier[int]
+	_, ch := sn.Load()
+
+	var calls atomic.Int32
+	init := func() int {
+		calls.Add(1)
+		return 0 // zero is a valid initialized value.
+	}
+	values := make(chan int, 10)
+	for i := 0; i < 10; i++ {
+		go func() {
+			values <- sn.LoadOrStore(init)
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		require.Equal(t, 0, <-values)
+	}
+	require.Equal(t, int32(1), calls.Load())
+	<-ch
+
+	sn.Store(5)
+	require.Equal(t, 5, sn.LoadOrStore(init))
+	require.Equal(t, int32(1), calls.Load())
+
+	// A notifier created with an initial value is already stored.
+	sn2 := collections.NewStatefulNotifier(1)
+	require.Equal(t, 1, sn2.LoadOrStore(init))
+	require.Equal(t, int32(1), calls.Load())
+}
+
 func TestNotifierWait(t *testing.T) {
 	ctx := context.Background()
 
@@ -131,6 +195,112 @@ func TestWatch(t *testing.T) {
 	}, 2*time.Second, 10*time.Millisecond)
 }
 
+func TestWatchUpdates(t *testing.T) {
+	sn := collections.NewStatefulNotifier(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recv := sn.WatchUpdates(ctx)
+	// Stored before iteration begins, but after the watch was setup.
+	sn.Store(1)
+
+	var values []int
+	for v := range recv {
+		values = append(values, v)
+		if v == 1 {
+			go sn.Store(2)
+		} else {
+			break
+		}
+	}
+	require.Equal(t, []int{1, 2}, values)
+
+	// Cancelling the context stops the iterator without yielding.
+	cancel()
+	for v := range sn.WatchUpdates(ctx) {
+		require.Fail(t, "unexpected value", "got %d", v)
+	}
+}
+
+func TestWatchInto(t *testing.T) {
+	sn := collections.NewStatefulNotifier(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	out := make(chan int)
+	sn.WatchInto(ctx, out)
+	require.Equal(t, 0, <-out)
+
+	sn.Store(1)
+	require.Equal(t, 1, <-out)
+
+	// A slow receiver skips to the latest value.
+	sn.Store(2)
+	sn.Store(3)
+	require.Eventually(t, func() bool {
+		select {
+		case v := <-out:
+			return v == 3
+		default:
+			return false
+		}
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	sn.Store(4)
+	select {
+	case v := <-out:
+		require.Fail(t, "unexpected value after cancel", "got %d", v)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestDeriveNotifier(t *testing.T) {
+	type config struct {
+		name  string
+		limit int
+	}
+	src := collections.NewStatefulNotifier(config{name: "a", limit: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	limit := collections.DeriveNotifier(ctx, src, func(c config) int {
+		return c.limit
+	})
+	v, ch := limit.Load()
+	require.Equal(t, 1, v)
+
+	// Changes which don't affect the projection are not notified.
+	src.Store(config{name: "b", limit: 1})
+	time.Sleep(10 * time.Millisecond)
+	select {
+	case <-ch:
+		require.Fail(t, "unexpected notification")
+	default:
+	}
+
+	src.Store(config{name: "b", limit: 2})
+	v, err := limit.Wait(ctx, func(v int) bool {
+		return v == 2
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, v)
+	select {
+	case <-ch:
+	default:
+		require.Fail(t, "expected notification")
+	}
+
+	// After cancel, the derived notifier no longer updates.
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	src.Store(config{name: "b", limit: 3})
+	time.Sleep(10 * time.Millisecond)
+	v, _ = limit.Load()
+	require.Equal(t, 2, v)
+}
+
 func TestNotifierWaitAny(t *testing.T) {
 	ctx := context.Background()
 
@@ -65,3 +65,46 @@ func WaitAnyMethod[T any, V any](ctx context.Context,
 		cases[chosen].Chan = reflect.ValueOf(ch)
 	}
 }
+
+// DeriveNotifier returns a new StatefulNotifier which holds the result of
+// applying fn to the value of src. A goroutine watches src and stores the
+// updated projection, until the context is cancelled.
+//
+// Listeners on the derived notifier are only notified when the projected
+// value changes, so updates to src which do not affect the projection are
+// suppressed. Like Watch, intermediate updates to src may be skipped.
+func DeriveNotifier[T any, U comparable](ctx context.Context, src *StatefulNotifier[T],
+	fn func(T) U) *StatefulNotifier[U] {
+
+	v, ch := src.Load()
+	dst := NewStatefulNotifier(fn(v))
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ch:
+				v, ch = src.Load()
+				storeIfChanged(dst, fn(v))
+			}
+		}
+	}()
+	return dst
+}
+
+// storeIfChanged is like Store, but does not notify listeners if the value
+// is unchanged.
+func storeIfChanged[T comparable](n *StatefulNotifier[T], value T) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.value == value {
+		return
+	}
+	n.value = value
+	n.set = true
+	if n.updated != nil {
+		close(n.updated)
+		n.updated = nil
+	}
+}
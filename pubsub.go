@@ -30,6 +30,9 @@ type message[T any] struct {
 // Publish a new value to the channel. This value will be sent to all subscribers.
 // Note that values are not persisted, so if no subscribers are listening when a
 // value is published, it will be lost.
+//
+// Subscriber callbacks are never called with the channel lock held, so it is
+// safe to call Publish from within a Subscribe, Watch or Receive callback.
 func (c *Channel[T]) Publish(value T) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
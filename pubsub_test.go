@@ -101,6 +101,132 @@ func TestPubSub_Receive(t *testing.T) {
 	require.Equal(t, 2016, sum2)
 }
 
+func TestPubSub_PublishFromSubscriber(t *testing.T) {
+	var c collections.Channel[int]
+
+	// Each received value republishes its successor until 10 is reached.
+	received := make(chan int, 16)
+	sub := c.Subscribe(func(v int) {
+		received <- v
+		if v < 10 {
+			c.Publish(v + 1)
+		}
+	})
+	defer sub.Cancel()
+
+	// Receive is also reentrant, since the loop body runs without the lock.
+	// The subscription is set up before Receive returns, so no values are
+	// missed.
+	seq := c.Receive()
+	watched := make(chan int, 16)
+	go func() {
+		for v := range seq {
+			watched <- v
+			if v == 10 {
+				c.Publish(100)
+			}
+			if v == 100 {
+				return
+			}
+		}
+	}()
+
+	c.Publish(0)
+	for i := 0; i <= 10; i++ {
+		select {
+		case <-time.After(2 * time.Second):
+			require.FailNow(t, "timeout, possible deadlock")
+		case got := <-received:
+			require.Equal(t, i, got)
+		}
+	}
+
+	select {
+	case <-time.After(2 * time.Second):
+		require.FailNow(t, "timeout, possible deadlock")
+	case got := <-received:
+		require.Equal(t, 100, got)
+	}
+
+	for _, want := range []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 100} {
+		select {
+		case <-time.After(2 * time.Second):
+			require.FailNow(t, "timeout, possible deadlock")
+		case got := <-watched:
+			require.Equal(t, want, got)
+		}
+	}
+}
+
+func TestDebounceChannel(t *testing.T) {
+	var src collections.Channel[int]
+	out, stop := collections.DebounceChannel(context.Background(), &src, 20*time.Millisecond)
+	defer stop()
+
+	recv := out.Receive()
+	go func() {
+		// A burst of values is debounced to the latest.
+		for i := 1; i <= 5; i++ {
+			src.Publish(i)
+		}
+		time.Sleep(100 * time.Millisecond)
+		src.Publish(6)
+		src.Publish(7)
+		src.Close()
+	}()
+
+	var got []int
+	for v := range recv {
+		got = append(got, v)
+	}
+	require.Equal(t, []int{5, 7}, got)
+}
+
+func TestDebounceChannel_Stop(t *testing.T) {
+	var src collections.Channel[int]
+	out, stop := collections.DebounceChannel(context.Background(), &src, 10*time.Millisecond)
+
+	received := make(chan int, 1)
+	sub := out.Subscribe(func(v int) {
+		received <- v
+	})
+	defer sub.Cancel()
+
+	src.Publish(1)
+	select {
+	case <-time.After(2 * time.Second):
+		require.Fail(t, "timeout")
+	case got := <-received:
+		require.Equal(t, 1, got)
+	}
+
+	stop()
+	time.Sleep(10 * time.Millisecond)
+	src.Publish(2)
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, received)
+}
+
+func TestDebounceChannel_StopCloses(t *testing.T) {
+	var src collections.Channel[int]
+	out, stop := collections.DebounceChannel(context.Background(), &src, 10*time.Millisecond)
+
+	recv := out.Receive()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range recv {
+		}
+	}()
+
+	stop()
+	select {
+	case <-time.After(2 * time.Second):
+		require.Fail(t, "receive loop did not end after stop")
+	case <-done:
+	}
+}
+
 func BenchmarkPubSub(b *testing.B) {
 	for _, n := range []int{0, 1, 10, 100, 1000} {
 		b.Run(fmt.Sprintf("PubSub-%d", n), func(b *testing.B) {
@@ -0,0 +1,57 @@
+package collections
+
+import (
+	"context"
+	"time"
+)
+
+// DebounceChannel returns a channel which republishes values from src, but only
+// once d has elapsed without any new values being published to src. Only the
+// latest value is republished, so a burst of values results in one value.
+//
+// The subscription to src is setup before the function returns. It runs until
+// the context is cancelled or the returned stop function is called, and then
+// the returned channel is closed. If src is closed, then any pending value is
+// published immediately before the returned channel is closed.
+func DebounceChannel[T any](ctx context.Context, src *Channel[T],
+	d time.Duration) (*Channel[T], func()) {
+
+	ctx, cancel := context.WithCancel(ctx)
+	out := new(Channel[T])
+	next := src.head()
+
+	go func() {
+		defer out.Close()
+		timer := time.NewTimer(d)
+		timer.Stop()
+		defer timer.Stop()
+
+		var latest T
+		var pending bool
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-next.final:
+				if next.closed {
+					if pending {
+						out.Publish(latest)
+					}
+					return
+				}
+				latest, pending = next.value, true
+				next = next.next
+				timer.Reset(d)
+
+			case <-timer.C:
+				if pending {
+					out.Publish(latest)
+					pending = false
+				}
+			}
+		}
+	}()
+
+	return out, cancel
+}
@@ -0,0 +1,75 @@
+package collections
+
+import (
+	"fmt"
+	"time"
+)
+
+// RateWindow counts events in a sliding time window, such as for rate limits
+// or metrics. Event times are stored in a fixed-size Ring, so at most
+// maxEvents are tracked; once the ring is full, the oldest event is evicted,
+// and the count saturates at maxEvents.
+//
+// Note that no synchronization is done. If the window is accessed
+// concurrently, it must be synchronized externally.
+type RateWindow struct {
+	window time.Duration
+	events *Ring[time.Time]
+}
+
+// NewRateWindow creates a RateWindow which counts events in the last window
+// duration, tracking at most maxEvents events. It panics if window is not
+// positive, since the rate over an empty window is undefined.
+func NewRateWindow(window time.Duration, maxEvents int) *RateWindow {
+	if window <= 0 {
+		panic(fmt.Sprintf("collections: rate window %v is not positive", window))
+	}
+	return &RateWindow{
+		window: window,
+		events: NewRing[time.Time](maxEvents),
+	}
+}
+
+// Observe records an event at the given time, and expires any events which
+// are older than the window relative to t.
+// Events are expected to be observed in time order.
+func (w *RateWindow) Observe(t time.Time) {
+	w.expire(t)
+	w.events.PushBackEvict(t)
+}
+
+// Count returns the number of events observed in the last window, relative to
+// the current time. This is equivalent to CountAt(time.Now()).
+func (w *RateWindow) Count() int {
+	return w.CountAt(time.Now())
+}
+
+// CountAt returns the number of events observed in the window ending at now.
+// This allows the window to be used with event times from a clock other than
+// the system clock. Events older than the window are expired, so now is
+// expected not to go backwards between calls.
+func (w *RateWindow) CountAt(now time.Time) int {
+	w.expire(now)
+	return w.events.Len()
+}
+
+// Rate returns the number of events per d, averaged over the window.
+// For example, Rate(time.Second) returns the number of events per second.
+// This is equivalent to RateAt(time.Now(), d).
+func (w *RateWindow) Rate(d time.Duration) float64 {
+	return w.RateAt(time.Now(), d)
+}
+
+// RateAt is like Rate, but for the window ending at now, as for CountAt.
+func (w *RateWindow) RateAt(now time.Time, d time.Duration) float64 {
+	return float64(w.CountAt(now)) * float64(d) / float64(w.window)
+}
+
+// expire removes events from the front of the ring which are older than the
+// window relative to now.
+func (w *RateWindow) expire(now time.Time) {
+	cutoff := now.Add(-w.window)
+	w.events.TrimFront(func(t time.Time) bool {
+		return !t.After(cutoff)
+	})
+}
@@ -0,0 +1,61 @@
+package collections_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arg0net/collections"
+)
+
+func TestRateWindow(t *testing.T) {
+	w := collections.NewRateWindow(time.Minute, 100)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	require.Equal(t, 0, w.CountAt(now))
+
+	w.Observe(now.Add(-3 * time.Minute))
+	w.Observe(now.Add(-2 * time.Minute))
+	require.Equal(t, 0, w.CountAt(now))
+
+	for i := 30; i > 0; i-- {
+		w.Observe(now.Add(-time.Duration(i) * time.Second))
+	}
+	require.Equal(t, 30, w.CountAt(now))
+	require.InDelta(t, 0.5, w.RateAt(now, time.Second), 0.001)
+	require.InDelta(t, 30.0, w.RateAt(now, time.Minute), 0.001)
+
+	// Events expire as the window moves forward.
+	require.Equal(t, 14, w.CountAt(now.Add(45*time.Second)))
+	require.Equal(t, 0, w.CountAt(now.Add(time.Minute)))
+}
+
+func TestRateWindow_Now(t *testing.T) {
+	w := collections.NewRateWindow(time.Minute, 100)
+	require.Equal(t, 0, w.Count())
+
+	now := time.Now()
+	w.Observe(now.Add(-2 * time.Minute))
+	w.Observe(now)
+	require.Equal(t, 1, w.Count())
+	require.InDelta(t, 1.0, w.Rate(time.Minute), 0.001)
+}
+
+func TestRateWindow_Saturates(t *testing.T) {
+	w := collections.NewRateWindow(time.Minute, 3)
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 10; i++ {
+		w.Observe(now)
+	}
+	require.Equal(t, 3, w.CountAt(now))
+}
+
+func TestRateWindow_InvalidWindow(t *testing.T) {
+	require.Panics(t, func() {
+		collections.NewRateWindow(0, 10)
+	})
+	require.Panics(t, func() {
+		collections.NewRateWindow(-time.Second, 10)
+	})
+}
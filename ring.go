@@ -1,13 +1,16 @@
 package collections
 
 import (
+	"context"
 	"fmt"
 	"iter"
+	"slices"
 )
 
 // Ring is a fixed-size ring buffer that supports pushing and popping elements,
 // as well as copying elements into a slice, and removing an element by index.
-// The ring is implemented as a single slice, which is never reallocated.
+// The ring is implemented as a single slice, which is only reallocated when
+// the ring is resized, or when a dynamic ring grows (see NewDynamicRing).
 //
 // Note that no synchronization is done. If the ring is accessed concurrently,
 // it must be synchronized externally.
@@ -17,6 +20,9 @@ type Ring[T any] struct {
 	// Left and right are slices of the elements slice.
 	left  []T // left half of the ring, when right is full and the ring wraps.
 	right []T // right half of the ring, containing start.
+
+	compact bool // normalize the layout while popping, see NewRingCompacting.
+	dynamic bool // grow rather than rejecting pushes when full, see NewDynamicRing.
 }
 
 // NewRing creates a new ring buffer with the given fixed size.
@@ -29,13 +35,95 @@ func NewRing[T any](fixedSize int) *Ring[T] {
 	}
 }
 
+// NewRingCentered creates a new ring buffer with the given fixed size, where
+// the first element is placed in the middle of the backing slice rather than at
+// the start. This leaves free space on both sides of the first element, so that
+// pushes onto either end can proceed without immediately wrapping around.
+//
+// The capacity is the same as NewRing: the ring holds at most fixedSize
+// elements, regardless of which end they were pushed onto. Only the initial
+// layout differs, and Reset returns the ring to the default layout.
+func NewRingCentered[T any](fixedSize int) *Ring[T] {
+	elements := make([]T, fixedSize)
+	mid := fixedSize / 2
+	return &Ring[T]{
+		elements: elements,
+		left:     elements[:0],
+		right:    elements[mid:mid],
+	}
+}
+
+// NewRingCompacting creates a new ring buffer with the given fixed size, which
+// keeps its elements mostly contiguous in memory.
+//
+// When the ring has wrapped around and the start of the ring passes the middle
+// of the backing slice, PopFront moves the elements back to the start of the
+// backing slice. This has an amortized cost of O(1) per pop, and means that
+// iteration (such as Copy, Scan and All) usually visits a single contiguous
+// region rather than two.
+func NewRingCompacting[T any](fixedSize int) *Ring[T] {
+	r := NewRing[T](fixedSize)
+	r.compact = true
+	return r
+}
+
+// NewDynamicRing creates a new ring buffer with the given initial size, which
+// grows as needed rather than becoming full. When an element is pushed onto a
+// full dynamic ring, the capacity is doubled and the elements are moved to a
+// new contiguous backing slice. Push operations on a dynamic ring always
+// succeed, and Cap reports the current capacity.
+func NewDynamicRing[T any](initialSize int) *Ring[T] {
+	r := NewRing[T](initialSize)
+	r.dynamic = true
+	return r
+}
+
+// RingFromSlice creates a new ring buffer holding a copy of data, in order,
+// with space for extraCap more elements. If extraCap is zero, the ring is
+// full. The ring does not retain data.
+func RingFromSlice[T any](data []T, extraCap int) *Ring[T] {
+	r := NewRing[T](len(data) + max(extraCap, 0))
+	r.right = r.elements[:copy(r.elements, data)]
+	return r
+}
+
+// NewRingFilled creates a new ring buffer with the given fixed size, which is
+// full of copies of fill. This is useful as a rolling window with an initial
+// value, combined with PushBackEvict.
+func NewRingFilled[T any](fixedSize int, fill T) *Ring[T] {
+	r := NewRing[T](fixedSize)
+	for i := range r.elements {
+		r.elements[i] = fill
+	}
+	r.right = r.elements
+	return r
+}
+
+// full returns whether the ring has no space for another element. A dynamic
+// ring is never full, since it grows instead.
+func (r *Ring[T]) full() bool {
+	return !r.dynamic && r.Len() == r.Cap()
+}
+
+// reserve grows a dynamic ring, if needed, so that there is space for n more
+// elements. It does nothing for a fixed-size ring.
+func (r *Ring[T]) reserve(n int) {
+	if r.dynamic && r.Cap()-r.Len() < n {
+		r.realloc(max(2*r.Cap(), r.Len()+n))
+	}
+}
+
 // PushBack adds the element to the ring. If the ring is full, it returns false.
 func (r *Ring[T]) PushBack(e T) bool {
 	switch {
 	case cap(r.right) > len(r.right):
 		r.right = append(r.right, e)
 	case len(r.left)+len(r.right) == cap(r.elements):
-		return false // ring is full
+		if !r.dynamic {
+			return false // ring is full
+		}
+		r.reserve(1)
+		r.right = append(r.right, e)
 	default:
 		// right side is full, so wrapping around on the left side.
 		r.left = append(r.left, e)
@@ -43,6 +131,140 @@ func (r *Ring[T]) PushBack(e T) bool {
 	return true
 }
 
+// PushBackEvict adds the element to the ring. If the ring is full, then the
+// first element is removed to make space, and is returned along with true.
+func (r *Ring[T]) PushBackEvict(e T) (T, bool) {
+	var evicted T
+	var didEvict bool
+	if r.full() {
+		evicted, didEvict = r.PopFront()
+	}
+	r.PushBack(e)
+	return evicted, didEvict
+}
+
+// PushFront adds the element to the front of the ring. If the ring is full,
+// it returns false.
+func (r *Ring[T]) PushFront(e T) bool {
+	if r.full() {
+		return false // ring is full
+	}
+	r.reserve(1)
+
+	start := cap(r.elements) - cap(r.right)
+	if start > len(r.left) {
+		// there is space immediately before the right side.
+		r.right = r.elements[start-1 : start+len(r.right)]
+	} else {
+		// the right side starts at the beginning of the elements, and the left
+		// side is empty, so wrap around to the end of the elements.
+		r.left = r.right
+		r.right = r.elements[len(r.elements)-1:]
+	}
+	r.right[0] = e
+	return true
+}
+
+// Insert adds the element at the given index, shifting later elements back by
+// one. The elements are shifted in whichever direction requires fewer moves,
+// which has a time complexity of O(n) in the worst case.
+//
+// If the ring is full or the index is out of bounds, it returns false.
+// Insert(0, v) is equivalent to PushFront, and Insert(Len(), v) is equivalent
+// to PushBack.
+func (r *Ring[T]) Insert(i int, v T) bool {
+	n := r.Len()
+	if i < 0 || i > n || r.full() {
+		return false
+	}
+
+	var zero T
+	if i < n/2 {
+		// Open a slot at the front, and shift the earlier elements forward.
+		r.PushFront(zero)
+		for j := 0; j < i; j++ {
+			*r.at(j) = *r.at(j + 1)
+		}
+	} else {
+		// Open a slot at the back, and shift the later elements backward.
+		r.PushBack(zero)
+		for j := n; j > i; j-- {
+			*r.at(j) = *r.at(j - 1)
+		}
+	}
+	*r.at(i) = v
+	return true
+}
+
+// PushBackSeq adds elements from the sequence to the ring, until the ring is
+// full or the sequence ends. It returns the number of elements added.
+// Once the ring is full, no more values are pulled from the sequence.
+// A dynamic ring is never full, so it consumes the whole sequence, and an
+// infinite sequence never returns.
+func (r *Ring[T]) PushBackSeq(seq iter.Seq[T]) int {
+	var n int
+	if r.full() {
+		return 0
+	}
+	for e := range seq {
+		r.PushBack(e)
+		n++
+		if r.full() {
+			break
+		}
+	}
+	return n
+}
+
+// MoveFrom removes up to n elements from the front of src, and adds them to
+// the back of the ring, until src is empty or the ring is full. It returns the
+// number of elements moved. Elements are copied a segment at a time, rather
+// than popped and pushed individually.
+func (r *Ring[T]) MoveFrom(src *Ring[T], n int) int {
+	r.reserve(min(n, src.Len()))
+	n = min(n, src.Len(), r.Cap()-r.Len())
+	if n <= 0 {
+		return 0
+	}
+	first, second := r.reserveBack(n)
+	copied := src.copyAt(0, first)
+	src.copyAt(copied, second)
+	return src.Skip(n)
+}
+
+// freeBack returns the contiguous free slots immediately after the last
+// element, which are the next slots filled by reserveBack.
+func (r *Ring[T]) freeBack() []T {
+	if len(r.left) == 0 && len(r.right) < cap(r.right) {
+		return r.right[len(r.right):cap(r.right)]
+	}
+	start := cap(r.elements) - cap(r.right)
+	return r.left[len(r.left):start]
+}
+
+// reserveBack extends the back of the ring by up to n elements, returning the
+// newly added slots in logical order. The slots may be split across the wrap,
+// so they are returned as two slices, either of which may be empty.
+func (r *Ring[T]) reserveBack(n int) (first, second []T) {
+	if len(r.left) == 0 {
+		// fill the remainder of the right side before wrapping.
+		k := min(n, cap(r.right)-len(r.right))
+		first = r.right[len(r.right) : len(r.right)+k]
+		r.right = r.right[:len(r.right)+k]
+		n -= k
+	}
+	if n == 0 || cap(r.right) != len(r.right) {
+		return first, nil
+	}
+
+	// the left side can grow up to the start of the right side.
+	start := cap(r.elements) - cap(r.right)
+	k := min(n, start-len(r.left))
+	second = r.left[len(r.left) : len(r.left)+k]
+	r.left = r.left[:len(r.left)+k]
+	return first, second
+}
+
 // PopFront removes and returns the first element in the ring.
 // If the ring is empty, it returns false.
 func (r *Ring[T]) PopFront() (T, bool) {
@@ -59,18 +281,107 @@ func (r *Ring[T]) PopFront() (T, bool) {
 		// right side is exhausted, so what was the left is now the right.
 		r.right = r.left
 		r.left = r.elements[:0]
+	} else if r.compact && len(r.left) > 0 && 2*cap(r.right) <= cap(r.elements) {
+		r.Normalize()
 	}
 	return el, true
 }
 
+// PopBack removes and returns the last element in the ring.
+// If the ring is empty, it returns false.
+func (r *Ring[T]) PopBack() (T, bool) {
+	var zero T
+	var el T
+	switch {
+	case len(r.left) > 0:
+		el = r.left[len(r.left)-1]
+		r.left[len(r.left)-1] = zero
+		r.left = r.left[:len(r.left)-1]
+	case len(r.right) > 0:
+		el = r.right[len(r.right)-1]
+		r.right[len(r.right)-1] = zero
+		r.right = r.right[:len(r.right)-1]
+	default:
+		return zero, false
+	}
+	return el, true
+}
+
+// DropBack removes the last n elements from the ring, zeroing their slots.
+// If n is at least the number of elements in the ring, then all elements are
+// removed. This is useful for rolling back elements which were speculatively
+// pushed onto the back of the ring.
+func (r *Ring[T]) DropBack(n int) {
+	n = min(max(n, 0), r.Len())
+	k := min(n, len(r.left))
+	clear(r.left[len(r.left)-k:])
+	r.left = r.left[:len(r.left)-k]
+	clear(r.right[len(r.right)-(n-k):])
+	r.right = r.right[:len(r.right)-(n-k)]
+}
+
+// SplitAt removes the elements from index i onwards, and returns them in a new
+// ring, leaving the first i elements in r. The new ring has the same capacity
+// as r, and grows or compacts like r. The index is clamped to [0, Len()], so
+// SplitAt(0) moves all elements to the new ring, and SplitAt(Len()) returns an
+// empty ring.
+func (r *Ring[T]) SplitAt(i int) *Ring[T] {
+	i = min(max(i, 0), r.Len())
+	tail := NewRing[T](r.Cap())
+	tail.compact, tail.dynamic = r.compact, r.dynamic
+	tail.right = tail.elements[:r.copyAt(i, tail.elements)]
+	r.DropBack(r.Len() - i)
+	return tail
+}
+
+// PopN removes up to len(out) elements from the front of the ring into the out
+// slice, in order, and returns the number of elements removed. It returns 0 if
+// the ring is empty. This is the consuming counterpart to Copy.
+func (r *Ring[T]) PopN(out []T) int {
+	return r.Skip(r.Copy(out))
+}
+
+// Normalize rearranges the backing slice so that all elements are contiguous,
+// starting at the beginning of the backing slice. It does not change the
+// logical order of the elements, nor the capacity of the ring.
+//
+// This is O(n) in the size of the ring, and is a no-op if the elements
+// already start at the beginning of the backing slice.
+func (r *Ring[T]) Normalize() {
+	start := cap(r.elements) - cap(r.right)
+	if start == 0 {
+		return // already contiguous.
+	}
+	count := r.Len()
+
+	if len(r.left) == 0 {
+		// contiguous, but not at the start; slide the elements down.
+		copy(r.elements, r.right)
+		clear(r.elements[max(count, start) : start+count])
+	} else {
+		// Rotate the whole backing slice so that start becomes index 0. Unused
+		// slots hold zero values, so they can be moved along with the elements.
+		slices.Reverse(r.elements[:start])
+		slices.Reverse(r.elements[start:])
+		slices.Reverse(r.elements)
+	}
+
+	r.right = r.elements[:count]
+	r.left = r.elements[:0]
+}
+
 // PopIndex removes and returns the element at the given index.
 // This will require copying elements to maintain the ring structure, which
 // has a time complexity of O(n) in the worst case.
 //
 // If the index is out of bounds, it returns false.
 // The index is 0-based, with 0 being the first element in the ring.
+// Negative indices count from the back, with -1 being the last element.
 // PopIndex(0) is equivalent to PopFront.
 func (r *Ring[T]) PopIndex(i int) (T, bool) {
+	if i < 0 {
+		i += r.Len()
+	}
 	if i == 0 {
 		return r.PopFront()
 	}
@@ -101,6 +412,142 @@ func (r *Ring[T]) PopIndex(i int) (T, bool) {
 	return el, true
 }
 
+// SwapRemove removes and returns the element at the given index, moving the
+// last element into its place. This does not preserve the order of the
+// elements, but unlike PopIndex, it is O(1).
+//
+// If the index is out of bounds, it returns false.
+func (r *Ring[T]) SwapRemove(i int) (T, bool) {
+	p := r.at(i)
+	if p == nil {
+		var zero T
+		return zero, false
+	}
+	el := *p
+	last, _ := r.PopBack()
+	if i < r.Len() {
+		*p = last
+	}
+	return el, true
+}
+
+// DrainBatch removes up to limit elements from the front of the ring, passing
+// them to fn as slices which alias the ring's storage. It returns the number of
+// elements removed.
+//
+// Elements are not moved to present a single slice. If the elements wrap
+// around the end of the ring, then fn is called twice, once for each
+// contiguous segment, in order. The function must not modify the ring, nor
+// retain the slice after returning, since the slots are zeroed afterwards.
+func (r *Ring[T]) DrainBatch(limit int, fn func([]T)) int {
+	n := min(limit, r.Len())
+	if n <= 0 {
+		return 0
+	}
+	k := min(n, len(r.right))
+	fn(r.right[:k])
+	if n > k {
+		fn(r.left[:n-k])
+	}
+	return r.Skip(n)
+}
+
+// TrimFront removes elements from the front of the ring while pred returns
+// true, stopping at the first element which does not match. It returns the
+// number of elements removed.
+func (r *Ring[T]) TrimFront(pred func(T) bool) int {
+	var n int
+	for {
+		e, ok := r.PeekFront()
+		if !ok || !pred(e) {
+			return n
+		}
+		r.PopFront()
+		n++
+	}
+}
+
+// TrimBack is like TrimFront, but removes elements from the back of the ring.
+func (r *Ring[T]) TrimBack(pred func(T) bool) int {
+	var n int
+	for {
+		e, ok := r.PeekBack()
+		if !ok || !pred(e) {
+			return n
+		}
+		r.PopBack()
+		n++
+	}
+}
+
+// RemoveFunc removes all elements for which pred returns true, keeping the
+// remaining elements in order. It returns the number of elements removed.
+// This is a single O(n) pass over the ring.
+func (r *Ring[T]) RemoveFunc(pred func(T) bool) int {
+	n := r.Len()
+	return n - r.retain(func(e T) bool { return !pred(e) })
+}
+
+// RetainFunc keeps only the elements for which keep returns true, in order,
+// and removes the rest. It returns the number of elements retained.
+// This is the inverse of RemoveFunc.
+func (r *Ring[T]) RetainFunc(keep func(T) bool) int {
+	return r.retain(keep)
+}
+
+// DedupFunc replaces each run of consecutive elements for which eq returns
+// true with the first element of the run, like slices.CompactFunc. It returns
+// the number of elements removed.
+func (r *Ring[T]) DedupFunc(eq func(a, b T) bool) int {
+	n := r.Len()
+	var prev T
+	first := true
+	return n - r.retain(func(e T) bool {
+		keep := first || !eq(e, prev)
+		prev, first = e, false
+		return keep
+	})
+}
+
+// retain keeps only the elements for which keep returns true, moving them
+// towards the front of the ring in order and zeroing the freed slots at the
+// back. It returns the number of elements kept.
+func (r *Ring[T]) retain(keep func(T) bool) int {
+	var kept int
+	for i := range r.Len() {
+		e := *r.at(i)
+		if !keep(e) {
+			continue
+		}
+		*r.at(kept) = e
+		kept++
+	}
+	r.DropBack(r.Len() - kept)
+	return kept
+}
+
+// DrainToChan sends elements from the front of the ring to the out channel,
+// removing each element once it has been sent, until the ring is empty or the
+// context is cancelled. It returns the number of elements sent.
+// Sending blocks until the receiver is ready, and elements which have not been
+// sent when the context is cancelled remain in the ring.
+func (r *Ring[T]) DrainToChan(ctx context.Context, out chan<- T) int {
+	var n int
+	for {
+		e, ok := r.PeekFront()
+		if !ok {
+			return n
+		}
+		select {
+		case <-ctx.Done():
+			return n
+		case out <- e:
+			r.PopFront()
+			n++
+		}
+	}
+}
+
 // PeekFront returns the first element in the ring without removing it.
 func (r *Ring[T]) PeekFront() (T, bool) {
 	if len(r.right) == 0 {
@@ -110,11 +557,27 @@ func (r *Ring[T]) PeekFront() (T, bool) {
 	return r.right[0], true
 }
 
+// PeekBack returns the last element in the ring without removing it.
+func (r *Ring[T]) PeekBack() (T, bool) {
+	switch {
+	case len(r.left) > 0:
+		return r.left[len(r.left)-1], true
+	case len(r.right) > 0:
+		return r.right[len(r.right)-1], true
+	}
+	var zero T
+	return zero, false
+}
+
 // PeekIndex returns the element at the given index without removing it.
 // If the index is out of bounds, it returns false.
 // The index is 0-based, with 0 being the first element in the ring.
+// Negative indices count from the back, with -1 being the last element.
 // PeekIndex(0) is equivalent to PeekFront.
 func (r *Ring[T]) PeekIndex(i int) (T, bool) {
+	if i < 0 {
+		i += r.Len()
+	}
 	if i == 0 {
 		return r.PeekFront()
 	}
@@ -130,16 +593,131 @@ func (r *Ring[T]) PeekIndex(i int) (T, bool) {
 	return r.right[i], true
 }
 
+// SetIndex replaces the element at the given index.
+// If the index is out of bounds, it returns false.
+// The index is 0-based, with 0 being the first element in the ring.
+func (r *Ring[T]) SetIndex(i int, v T) bool {
+	p := r.at(i)
+	if p == nil {
+		return false
+	}
+	*p = v
+	return true
+}
+
+// ReplaceIndex replaces the element at the given index, and returns the
+// previous element. If the index is out of bounds, it returns false.
+func (r *Ring[T]) ReplaceIndex(i int, v T) (T, bool) {
+	p := r.at(i)
+	if p == nil {
+		var zero T
+		return zero, false
+	}
+	old := *p
+	*p = v
+	return old, true
+}
+
+// Swap exchanges the elements at the given indices. If either index is out of
+// bounds, it returns false. Together with Len and PeekIndex, this allows
+// sorting the ring in place, for example by implementing sort.Interface.
+func (r *Ring[T]) Swap(i, j int) bool {
+	a, b := r.at(i), r.at(j)
+	if a == nil || b == nil {
+		return false
+	}
+	*a, *b = *b, *a
+	return true
+}
+
+// at returns a pointer to the slot holding the element at the given index,
+// or nil if the index is out of bounds.
+func (r *Ring[T]) at(i int) *T {
+	if i < 0 || i >= r.Len() {
+		return nil
+	}
+	idx := i - len(r.right)
+	if idx >= 0 {
+		return &r.left[idx]
+	}
+	return &r.right[i]
+}
+
+// Reverse reverses the order of the elements in the ring, in place.
+func (r *Ring[T]) Reverse() {
+	for i, j := 0, r.Len()-1; i < j; i, j = i+1, j-1 {
+		a, b := r.at(i), r.at(j)
+		*a, *b = *b, *a
+	}
+}
+
+// Rotate rotates the elements of the ring so that the element at index n
+// becomes the first element. Negative values of n rotate in the opposite
+// direction, and n wraps around the length of the ring.
+//
+// If the ring is full, this only moves the start of the ring. Otherwise,
+// elements are moved from one end of the ring to the other, in whichever
+// direction requires fewer moves.
+func (r *Ring[T]) Rotate(n int) {
+	count := r.Len()
+	if count == 0 {
+		return
+	}
+	k := (n%count + count) % count
+	if k == 0 {
+		return
+	}
+
+	if count == r.Cap() {
+		start := cap(r.elements) - cap(r.right)
+		start = (start + k) % count
+		r.right = r.elements[start:]
+		r.left = r.elements[:start]
+		return
+	}
+
+	if k <= count/2 {
+		for range k {
+			e, _ := r.PopFront()
+			r.PushBack(e)
+		}
+	} else {
+		for range count - k {
+			e, _ := r.PopBack()
+			r.PushFront(e)
+		}
+	}
+}
+
 // Len returns the number of elements in the ring.
 func (r *Ring[T]) Len() int {
 	return len(r.left) + len(r.right)
 }
 
-// Cap returns the fixed size of the ring. This is constant for the lifetime of the ring.
+// Cap returns the current capacity of the ring. This changes as a dynamic ring
+// grows, and when the ring is resized, such as with Resize or SetCap.
 func (r *Ring[T]) Cap() int {
 	return cap(r.elements)
 }
 
+// String formats the elements of the ring in order, followed by the capacity,
+// such as "[1 2 3]/cap=5". Each element is formatted with fmt.
+func (r *Ring[T]) String() string {
+	return fmt.Sprintf("%v/cap=%d", r.ToSlice(), r.Cap())
+}
+
+// AsSlices returns the elements of the ring as two slices, in order, which
+// alias the ring's storage. The first slice holds the first element, and the
+// second slice is only non-empty if the elements wrap around the end of the
+// ring. This allows zero-copy access, such as for scatter/gather I/O.
+// Call Normalize first if a single slice is needed.
+//
+// The slices must not be modified, and are only valid until the next call
+// which modifies the ring.
+func (r *Ring[T]) AsSlices() (first, second []T) {
+	return r.right, r.left
+}
+
 // Copy makes a copy of the first n elements of the ring into the out slice.
 // It returns the number of elements copied.
 // This does not consume elements from the ring.
@@ -148,19 +726,160 @@ func (r *Ring[T]) Copy(out []T) int {
 	return idx + copy(out[idx:], r.left)
 }
 
-// Resize changes the size of the ring.
-// The new size must be greater than or equal to the current size.
+// ToSlice returns a new slice holding the elements of the ring, in order.
+// The slice does not alias the ring's storage.
+func (r *Ring[T]) ToSlice() []T {
+	out := make([]T, r.Len())
+	r.Copy(out)
+	return out
+}
+
+// CopyN is like Copy, but copies at most n elements.
+// It returns the number of elements copied.
+func (r *Ring[T]) CopyN(out []T, n int) int {
+	return r.Copy(out[:min(max(n, 0), len(out))])
+}
+
+// PeekRange copies the elements with indices in [start, end) into the out
+// slice, until out is full. It returns the number of elements copied.
+// The range is clamped to the elements in the ring, so out of range indices
+// copy fewer elements, rather than failing.
+// This does not consume elements from the ring.
+func (r *Ring[T]) PeekRange(start, end int, out []T) int {
+	start = min(max(start, 0), r.Len())
+	end = min(max(end, start), r.Len())
+	return r.copyAt(start, out[:min(len(out), end-start)])
+}
+
+// copyAt copies elements starting at the given index into the out slice,
+// and returns the number of elements copied.
+func (r *Ring[T]) copyAt(offset int, out []T) int {
+	var n int
+	if offset < len(r.right) {
+		n = copy(out, r.right[offset:])
+		offset = 0
+	} else {
+		offset -= len(r.right)
+	}
+	if offset >= len(r.left) {
+		return n
+	}
+	return n + copy(out[n:], r.left[offset:])
+}
+
+// CopyIf copies the elements of the ring which match the predicate into the
+// out slice, in order, until out is full. It returns the number of elements
+// copied. This does not consume elements from the ring.
+func (r *Ring[T]) CopyIf(out []T, pred func(T) bool) int {
+	var n int
+	for _, half := range [2][]T{r.right, r.left} {
+		for _, e := range half {
+			if n == len(out) {
+				return n
+			}
+			if pred(e) {
+				out[n] = e
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// CopyEvery copies every stride-th element of the ring into the out slice,
+// starting with the first element, until out is full. It returns the number of
+// elements copied. This does not consume elements from the ring.
+// If stride is not positive, nothing is copied.
+func (r *Ring[T]) CopyEvery(stride int, out []T) int {
+	if stride <= 0 {
+		return 0
+	}
+	var n int
+	i := 0
+	for ; i < len(r.right) && n < len(out); i += stride {
+		out[n] = r.right[i]
+		n++
+	}
+	for i -= len(r.right); i < len(r.left) && n < len(out); i += stride {
+		out[n] = r.left[i]
+		n++
+	}
+	return n
+}
+
+// Resize changes the size of the ring, reallocating the backing slice.
+// The new size may be smaller than the current capacity, but must be greater
+// than or equal to the number of elements in the ring.
 func (r *Ring[T]) Resize(newSize int) error {
 	if newSize < r.Len() {
 		return fmt.Errorf("new size %d is too small to hold %d elements", newSize, r.Len())
 	}
+	r.realloc(newSize)
+	return nil
+}
+
+// GrowBy increases the capacity of the ring by extra elements, reallocating
+// the backing slice as Resize does. It returns an error if extra is negative.
+func (r *Ring[T]) GrowBy(extra int) error {
+	if extra < 0 {
+		return fmt.Errorf("cannot grow by negative size %d", extra)
+	}
+	return r.Resize(r.Cap() + extra)
+}
+
+// ShrinkToFit resizes the ring so that its capacity equals the number of
+// elements, releasing any unused space.
+func (r *Ring[T]) ShrinkToFit() {
+	r.realloc(r.Len())
+}
+
+// TrimCapacity releases unused space if the ring has more than maxWaste free
+// slots, by resizing the ring so that its capacity is Len()+maxWaste. It
+// returns whether the ring was resized. This is useful to periodically reclaim
+// memory from a ring which grew during a burst, without reallocating every
+// time.
+func (r *Ring[T]) TrimCapacity(maxWaste int) bool {
+	maxWaste = max(maxWaste, 0)
+	if r.Cap()-r.Len() <= maxWaste {
+		return false
+	}
+	r.realloc(r.Len() + maxWaste)
+	return true
+}
+
+// SetCap changes the capacity of the ring to exactly n, and returns the number
+// of elements dropped. If n is at least the current length, all elements are
+// preserved. Otherwise, the oldest elements are dropped from the front of the
+// ring, and the newest n elements are preserved.
+//
+// SetCap always reallocates the backing slice, even if the capacity is
+// unchanged. It panics if n is negative, without modifying the ring.
+func (r *Ring[T]) SetCap(n int) int {
+	if n < 0 {
+		panic(fmt.Sprintf("collections: negative ring capacity %d", n))
+	}
+	dropped := r.Skip(r.Len() - n)
+	r.realloc(n)
+	return dropped
+}
 
-	els := make([]T, newSize)
+// Clone returns a copy of the ring, with the same capacity and elements.
+// The copy has its own backing slice, so changes to either ring do not
+// affect the other.
+func (r *Ring[T]) Clone() *Ring[T] {
+	c := *r
+	c.realloc(r.Cap())
+	return &c
+}
+
+// realloc moves the elements into a new backing slice of the given size,
+// which must be large enough to hold all of the elements.
+func (r *Ring[T]) realloc(size int) {
+	els := make([]T, size)
 	count := r.Copy(els)
-	r.left = els[:count]
-	r.right = els[:0]
+	r.right = els[:count]
+	r.left = els[:0]
 	r.elements = els
-	return nil
 }
 
 // Reset removes all elements from the ring.
@@ -170,6 +889,35 @@ func (r *Ring[T]) Reset() {
 	clear(r.elements)
 }
 
+// ResetNoZero removes all elements from the ring, like Reset, but without
+// zeroing the backing slice. This avoids O(n) work when the slots are about to
+// be overwritten. However, the removed elements remain in the backing slice
+// until they are overwritten, so if T holds pointers, then the values they
+// refer to cannot be garbage collected in the meantime.
+func (r *Ring[T]) ResetNoZero() {
+	r.left = r.elements[:0]
+	r.right = r.elements[:0]
+}
+
+// Skip removes up to n elements from the front of the ring, and returns the
+// number of elements removed, which is less than n if the ring has fewer
+// elements. The slots of the removed elements are zeroed, so that they do not
+// retain references. Skip is the front counterpart of DropBack.
+func (r *Ring[T]) Skip(n int) int {
+	n = min(max(n, 0), r.Len())
+	k := min(n, len(r.right))
+	clear(r.right[:k])
+	r.right = r.right[k:]
+	if cap(r.right) == 0 {
+		// right side is exhausted, so what was the left is now the right.
+		r.right = r.left
+		r.left = r.elements[:0]
+	}
+	clear(r.right[:n-k])
+	r.right = r.right[n-k:]
+	return n
+}
+
 // Scan calls the given function for each element in the ring, in order.
 // If the function returns true, then the value and index of the element are returned.
 // If no match is found, then returns the zero value of T and -1.
@@ -188,6 +936,102 @@ func (r *Ring[T]) Scan(fn func(T) bool) (T, int) {
 	return zero, -1
 }
 
+// Chunks returns a sequence of consecutive chunks of the ring, each holding
+// size elements, except the last chunk which may be shorter. Each chunk is a
+// new slice, so may be retained by the caller. This does not consume elements
+// from the ring. If size is not positive, the sequence is empty.
+func (r *Ring[T]) Chunks(size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+		for offset := 0; offset < r.Len(); offset += size {
+			chunk := make([]T, min(size, r.Len()-offset))
+			r.copyAt(offset, chunk)
+			if !yield(chunk) {
+				return
+			}
+		}
+	}
+}
+
+// DrainChunks is like Chunks, but removes each chunk from the front of the
+// ring as it is yielded. If the iteration stops early, then elements which
+// have not been yielded remain in the ring.
+func (r *Ring[T]) DrainChunks(size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+		for r.Len() > 0 {
+			chunk := make([]T, min(size, r.Len()))
+			r.Skip(r.Copy(chunk))
+			if !yield(chunk) {
+				return
+			}
+		}
+	}
+}
+
+// Equal returns whether both rings hold the same elements, in order, using eq
+// to compare elements. The capacity and layout of the rings are ignored.
+func (r *Ring[T]) Equal(other *Ring[T], eq func(a, b T) bool) bool {
+	if r.Len() != other.Len() {
+		return false
+	}
+	for i := range r.Len() {
+		if !eq(*r.at(i), *other.at(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualSlice returns whether the elements of the ring are equal to the
+// elements of the slice, in order, using eq to compare elements.
+func (r *Ring[T]) EqualSlice(s []T, eq func(a, b T) bool) bool {
+	if r.Len() != len(s) {
+		return false
+	}
+	for i, e := range r.right {
+		if !eq(e, s[i]) {
+			return false
+		}
+	}
+	for i, e := range r.left {
+		if !eq(e, s[i+len(r.right)]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns the first element in the ring for which the function returns
+// true. If no match is found, then returns the zero value of T and false.
+func (r *Ring[T]) Find(fn func(T) bool) (T, bool) {
+	v, idx := r.Scan(fn)
+	return v, idx >= 0
+}
+
+// ContainsFunc returns whether any element in the ring matches the predicate.
+// It stops at the first match.
+func (r *Ring[T]) ContainsFunc(pred func(T) bool) bool {
+	_, idx := r.Scan(pred)
+	return idx >= 0
+}
+
+// Count returns the number of elements in the ring which match the predicate.
+// This does not consume elements from the ring.
+func (r *Ring[T]) Count(pred func(T) bool) int {
+	var n int
+	for e := range r.All() {
+		if pred(e) {
+			n++
+		}
+	}
+	return n
+}
+
 // All returns a sequence of all elements in the ring.
 func (r *Ring[T]) All() iter.Seq[T] {
 	return func(yield func(T) bool) {
@@ -203,3 +1047,51 @@ func (r *Ring[T]) All() iter.Seq[T] {
 		}
 	}
 }
+
+// AllIndexed returns a sequence of all elements in the ring, in the same order
+// as All, along with the index of each element.
+func (r *Ring[T]) AllIndexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, e := range r.right {
+			if !yield(i, e) {
+				return
+			}
+		}
+		for i, e := range r.left {
+			if !yield(len(r.right)+i, e) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence of all elements in the ring, in reverse order.
+func (r *Ring[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(r.left) - 1; i >= 0; i-- {
+			if !yield(r.left[i]) {
+				return
+			}
+		}
+		for i := len(r.right) - 1; i >= 0; i-- {
+			if !yield(r.right[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns a sequence which removes each element from the front of the
+// ring as it is yielded, until the ring is empty. If the iteration stops early,
+// then elements which have not been yielded remain in the ring. Unlike All, the
+// ring is modified during iteration.
+func (r *Ring[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			e, ok := r.PopFront()
+			if !ok || !yield(e) {
+				return
+			}
+		}
+	}
+}
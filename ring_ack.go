@@ -0,0 +1,67 @@
+package collections
+
+// AckRing wraps a Ring with a read cursor, for at-least-once processing.
+// Elements are read with PeekUnacked, which advances the cursor without
+// removing them. Once processed, read elements are removed with Commit, or the
+// cursor is moved back to the front with Rollback so that they are read again.
+//
+// Only operations which keep the cursor consistent are provided. The wrapped
+// ring must not be modified directly afterwards.
+//
+// Like Ring, no synchronization is done.
+type AckRing[T any] struct {
+	ring    *Ring[T]
+	unacked int // number of elements read by PeekUnacked, but not committed.
+}
+
+// NewAckRing creates a new AckRing which wraps the given ring, with the read
+// cursor at the front of the ring.
+func NewAckRing[T any](r *Ring[T]) *AckRing[T] {
+	return &AckRing[T]{ring: r}
+}
+
+// PushBack adds the element to the ring. If the ring is full, it returns false.
+func (a *AckRing[T]) PushBack(e T) bool {
+	return a.ring.PushBack(e)
+}
+
+// PopFront removes and returns the first element in the ring, whether or not it
+// has been read by PeekUnacked. If the ring is empty, it returns false.
+func (a *AckRing[T]) PopFront() (T, bool) {
+	v, ok := a.ring.PopFront()
+	if ok && a.unacked > 0 {
+		a.unacked--
+	}
+	return v, ok
+}
+
+// Len returns the number of elements in the ring, including elements which
+// have been read but not committed.
+func (a *AckRing[T]) Len() int {
+	return a.ring.Len()
+}
+
+// PeekUnacked returns the first element which has not yet been read by
+// PeekUnacked, without removing it from the ring, and advances the read cursor.
+// If there are no unread elements, it returns false.
+func (a *AckRing[T]) PeekUnacked() (T, bool) {
+	v, ok := a.ring.PeekIndex(a.unacked)
+	if ok {
+		a.unacked++
+	}
+	return v, ok
+}
+
+// Commit removes up to n elements which have been read by PeekUnacked from the
+// front of the ring, and returns the number of elements removed.
+func (a *AckRing[T]) Commit(n int) int {
+	n = a.ring.Skip(min(n, a.unacked))
+	a.unacked -= n
+	return n
+}
+
+// Rollback resets the read cursor to the front of the ring, so that elements
+// which were read by PeekUnacked but not committed are read again.
+func (a *AckRing[T]) Rollback() {
+	a.unacked = 0
+}
@@ -0,0 +1,77 @@
+package collections_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arg0net/collections"
+)
+
+func TestAckRing(t *testing.T) {
+	ring := collections.NewRing[int](4)
+	r := collections.NewAckRing(ring)
+	for i := 1; i <= 4; i++ {
+		r.PushBack(i)
+	}
+
+	v, ok := r.PeekUnacked()
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+	v, ok = r.PeekUnacked()
+	require.True(t, ok)
+	require.Equal(t, 2, v)
+
+	// Processing failed, so read again from the front.
+	r.Rollback()
+	v, _ = r.PeekUnacked()
+	require.Equal(t, 1, v)
+	v, _ = r.PeekUnacked()
+	require.Equal(t, 2, v)
+	v, _ = r.PeekUnacked()
+	require.Equal(t, 3, v)
+
+	// Only read elements can be committed.
+	require.Equal(t, 2, r.Commit(2))
+	require.Equal(t, []int{3, 4}, slices.Collect(ring.All()))
+	require.Equal(t, 1, r.Commit(5))
+	require.Equal(t, []int{4}, slices.Collect(ring.All()))
+
+	// Wrap the ring around.
+	r.PushBack(5)
+	r.PushBack(6)
+	r.PushBack(7)
+	require.False(t, r.PushBack(8))
+	for i := 4; i <= 7; i++ {
+		v, ok = r.PeekUnacked()
+		require.True(t, ok)
+		require.Equal(t, i, v)
+	}
+	_, ok = r.PeekUnacked()
+	require.False(t, ok)
+
+	// Popping consumes from the read prefix.
+	v, _ = r.PopFront()
+	require.Equal(t, 4, v)
+	require.Equal(t, 3, r.Commit(4))
+	require.Equal(t, 0, r.Len())
+	require.Equal(t, 0, r.Commit(1))
+}
+
+func TestAckRing_PopUnread(t *testing.T) {
+	r := collections.NewAckRing(collections.NewRing[int](4))
+	for i := 1; i <= 3; i++ {
+		r.PushBack(i)
+	}
+
+	// Popping past the read prefix leaves nothing to commit.
+	r.PeekUnacked()
+	r.PopFront()
+	r.PopFront()
+	require.Equal(t, 0, r.Commit(1))
+	v, ok := r.PeekUnacked()
+	require.True(t, ok)
+	require.Equal(t, 3, v)
+	require.Equal(t, 1, r.Commit(1))
+}
@@ -0,0 +1,186 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// ByteRing is a fixed-size ring buffer of bytes. In addition to the methods
+// of Ring, it provides byte-oriented methods such as io.Writer.
+//
+// Like Ring, no synchronization is done.
+type ByteRing struct {
+	Ring[byte]
+}
+
+// NewByteRing creates a new byte ring buffer with the given fixed size.
+func NewByteRing(fixedSize int) *ByteRing {
+	return &ByteRing{Ring: *NewRing[byte](fixedSize)}
+}
+
+// Write appends the bytes to the back of the ring.
+// If the ring does not have space for all of p, then as many bytes as will fit
+// are written, and io.ErrShortWrite is returned along with the count.
+func (b *ByteRing) Write(p []byte) (int, error) {
+	first, second := b.reserveBack(len(p))
+	n := copy(first, p)
+	n += copy(second, p[n:])
+	if n < len(p) {
+		return n, io.ErrShortWrite
+	}
+	return n, nil
+}
+
+// WriteString is like Write, but copies directly from the string to avoid
+// converting it to a byte slice.
+func (b *ByteRing) WriteString(s string) (int, error) {
+	first, second := b.reserveBack(len(s))
+	n := copy(first, s)
+	n += copy(second, s[n:])
+	if n < len(s) {
+		return n, io.ErrShortWrite
+	}
+	return n, nil
+}
+
+// ReadByte removes and returns the first byte in the ring.
+// If the ring is empty, it returns io.EOF. This implements io.ByteReader.
+func (b *ByteRing) ReadByte() (byte, error) {
+	c, ok := b.PopFront()
+	if !ok {
+		return 0, io.EOF
+	}
+	return c, nil
+}
+
+// WriteByte adds the byte to the back of the ring.
+// If the ring is full, it returns io.ErrShortWrite. This implements
+// io.ByteWriter.
+func (b *ByteRing) WriteByte(c byte) error {
+	if !b.PushBack(c) {
+		return io.ErrShortWrite
+	}
+	return nil
+}
+
+// ReadFrom reads from src directly into the free space of the ring, until src
+// returns io.EOF or an error. It returns the number of bytes read, and reaching
+// the end of src is not an error. This implements io.ReaderFrom.
+//
+// If the ring becomes full before src reaches the end, then the remaining data
+// is left in src, and io.ErrShortWrite is returned along with the count,
+// matching Write.
+func (b *ByteRing) ReadFrom(src io.Reader) (int64, error) {
+	var total int64
+	var empty int
+	for {
+		free := b.freeBack()
+		if len(free) == 0 {
+			return total, io.ErrShortWrite // ring is full
+		}
+		n, err := src.Read(free)
+		b.reserveBack(n)
+		total += int64(n)
+		switch {
+		case err == io.EOF:
+			return total, nil
+		case err != nil:
+			return total, err
+		case n > 0:
+			empty = 0
+		default:
+			// guard against readers which never make progress.
+			empty++
+			if empty >= 100 {
+				return total, io.ErrNoProgress
+			}
+		}
+	}
+}
+
+// WriteTo writes the contents of the ring to dst, directly from the ring's
+// storage, and removes the bytes which were written. It returns the number of
+// bytes written, and any error from dst. On a short write, only the bytes
+// which were written are removed. This implements io.WriterTo.
+func (b *ByteRing) WriteTo(dst io.Writer) (int64, error) {
+	var total int64
+	for b.Len() > 0 {
+		// the right side always holds the first byte.
+		segment := b.right
+		n, err := dst.Write(segment)
+		b.Skip(n)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+		if n < len(segment) {
+			return total, io.ErrShortWrite
+		}
+	}
+	return total, nil
+}
+
+// WriteToBuffer appends the contents of the ring to the buffer, and returns
+// the number of bytes appended. Unlike WriteTo, this does not consume bytes
+// from the ring.
+// The bytes are written directly from the ring's storage, with one Write for
+// each contiguous segment.
+func (b *ByteRing) WriteToBuffer(buf *bytes.Buffer) int {
+	buf.Grow(b.Len())
+	n, _ := buf.Write(b.right)
+	m, _ := buf.Write(b.left)
+	return n + m
+}
+
+// Bytes returns the contents of the ring as a single slice. If the contents
+// wrap around the end of the ring, then the ring is first normalized (see
+// Normalize) so that the contents are contiguous, which is O(n). Otherwise no
+// bytes are moved.
+//
+// The returned slice aliases the ring's storage. It is only valid until the
+// next call which modifies the ring.
+func (b *ByteRing) Bytes() []byte {
+	if len(b.left) > 0 {
+		b.Normalize()
+	}
+	return b.right
+}
+
+// ByteAt returns the byte at the given index without removing it.
+// It is equivalent to PeekIndex.
+func (b *ByteRing) ByteAt(i int) (byte, bool) {
+	return b.PeekIndex(i)
+}
+
+// Uint16BE decodes a big-endian uint16 from the two bytes starting at the
+// given index, without removing them. If there are not enough bytes in the
+// ring, it returns false.
+func (b *ByteRing) Uint16BE(offset int) (uint16, bool) {
+	var buf [2]byte
+	if !b.peekAt(offset, buf[:]) {
+		return 0, false
+	}
+	return binary.BigEndian.Uint16(buf[:]), true
+}
+
+// Uint32BE decodes a big-endian uint32 from the four bytes starting at the
+// given index, without removing them. If there are not enough bytes in the
+// ring, it returns false.
+func (b *ByteRing) Uint32BE(offset int) (uint32, bool) {
+	var buf [4]byte
+	if !b.peekAt(offset, buf[:]) {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(buf[:]), true
+}
+
+// peekAt fills out with the bytes starting at the given index, handling the
+// wrap around. If there are not enough bytes, it returns false.
+func (b *ByteRing) peekAt(offset int, out []byte) bool {
+	if offset < 0 || offset+len(out) > b.Len() {
+		return false
+	}
+	b.copyAt(offset, out)
+	return true
+}
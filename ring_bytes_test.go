@@ -0,0 +1,227 @@
+package collections_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arg0net/collections"
+)
+
+func TestByteRingWrite(t *testing.T) {
+	r := collections.NewByteRing(8)
+	buf := make([]byte, 8)
+
+	n, err := r.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+
+	// Consume some bytes, so the next write wraps around.
+	for range 3 {
+		r.PopFront()
+	}
+	n, err = r.WriteString("world!")
+	require.NoError(t, err)
+	require.Equal(t, 6, n)
+	require.Equal(t, 8, r.Copy(buf))
+	require.Equal(t, "loworld!", string(buf))
+
+	// The ring is full.
+	n, err = r.WriteString("x")
+	require.ErrorIs(t, err, io.ErrShortWrite)
+	require.Equal(t, 0, n)
+
+	r.PopFront()
+	r.PopFront()
+	n, err = r.Write([]byte("abc"))
+	require.ErrorIs(t, err, io.ErrShortWrite)
+	require.Equal(t, 2, n)
+	require.Equal(t, 8, r.Copy(buf))
+	require.Equal(t, "world!ab", string(buf))
+}
+
+func TestByteRingWriteString_Allocs(t *testing.T) {
+	r := collections.NewByteRing(64)
+	s := "some log line"
+	allocs := testing.AllocsPerRun(100, func() {
+		r.Reset()
+		_, _ = r.WriteString(s)
+	})
+	require.Zero(t, allocs)
+}
+
+func TestByteRingUint(t *testing.T) {
+	r := collections.NewByteRing(8)
+	_, _ = r.Write([]byte{0, 0, 0, 0, 0, 0})
+	for range 5 {
+		r.PopFront()
+	}
+	// Write a header which wraps around the end of the ring.
+	_, err := r.Write([]byte{0x12, 0x34, 0x56, 0x78, 0x9a})
+	require.NoError(t, err)
+
+	b, ok := r.ByteAt(1)
+	require.True(t, ok)
+	require.Equal(t, byte(0x12), b)
+
+	v16, ok := r.Uint16BE(2)
+	require.True(t, ok)
+	require.Equal(t, uint16(0x3456), v16)
+
+	v32, ok := r.Uint32BE(1)
+	require.True(t, ok)
+	require.Equal(t, uint32(0x12345678), v32)
+	v32, ok = r.Uint32BE(2)
+	require.True(t, ok)
+	require.Equal(t, uint32(0x3456789a), v32)
+
+	_, ok = r.Uint32BE(3)
+	require.False(t, ok)
+	_, ok = r.Uint16BE(-1)
+	require.False(t, ok)
+	_, ok = r.ByteAt(6)
+	require.False(t, ok)
+}
+
+func TestByteRingWriteToBuffer(t *testing.T) {
+	r := collections.NewByteRing(8)
+	_, _ = r.WriteString("abcdef")
+	for range 4 {
+		r.PopFront()
+	}
+	_, _ = r.WriteString("ghij") // wraps around.
+
+	var buf bytes.Buffer
+	buf.WriteString(">")
+	require.Equal(t, 6, r.WriteToBuffer(&buf))
+	require.Equal(t, ">efghij", buf.String())
+	require.Equal(t, 6, r.Len())
+}
+
+func TestByteRingReadFrom(t *testing.T) {
+	r := collections.NewByteRing(8)
+	_, _ = r.WriteString("abcde")
+	for range 3 {
+		r.PopFront()
+	}
+
+	// Fills the right side, then wraps around to the left.
+	n, err := r.ReadFrom(strings.NewReader("fghij"))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), n)
+	buf := make([]byte, 8)
+	require.Equal(t, 7, r.Copy(buf))
+	require.Equal(t, "defghij", string(buf[:7]))
+
+	// Stops when the ring is full, leaving the rest of the reader.
+	src := strings.NewReader("klm")
+	n, err = r.ReadFrom(src)
+	require.ErrorIs(t, err, io.ErrShortWrite)
+	require.Equal(t, int64(1), n)
+	require.Equal(t, 2, src.Len())
+
+	// io.Copy reports the truncation, rather than success.
+	r = collections.NewByteRing(4)
+	copied, err := io.Copy(r, struct{ io.Reader }{strings.NewReader("hello world")})
+	require.ErrorIs(t, err, io.ErrShortWrite)
+	require.Equal(t, int64(4), copied)
+	require.Equal(t, "hell", string(r.Bytes()))
+
+	// Errors from the reader are returned.
+	r.Reset()
+	failing := io.MultiReader(strings.NewReader("xy"), iotest.ErrReader(errRead))
+	n, err = r.ReadFrom(failing)
+	require.ErrorIs(t, err, errRead)
+	require.Equal(t, int64(2), n)
+	require.Equal(t, 2, r.Len())
+}
+
+var errRead = errors.New("read failed")
+
+func TestByteRingWriteTo(t *testing.T) {
+	r := collections.NewByteRing(8)
+	_, _ = r.WriteString("abcdef")
+	for range 4 {
+		r.PopFront()
+	}
+	_, _ = r.WriteString("ghij") // wraps around.
+
+	var sb strings.Builder
+	n, err := r.WriteTo(&sb)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), n)
+	require.Equal(t, "efghij", sb.String())
+	require.Equal(t, 0, r.Len())
+
+	// On a short write, only the written bytes are removed.
+	_, _ = r.WriteString("klmnop")
+	w := &limitedWriter{limit: 4}
+	n, err = r.WriteTo(w)
+	require.ErrorIs(t, err, io.ErrShortWrite)
+	require.Equal(t, int64(4), n)
+	require.Equal(t, "klmn", w.String())
+	buf := make([]byte, 8)
+	require.Equal(t, 2, r.Copy(buf))
+	require.Equal(t, "op", string(buf[:2]))
+}
+
+// limitedWriter accepts up to limit bytes, then makes short writes.
+type limitedWriter struct {
+	bytes.Buffer
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	n := min(len(p), w.limit-w.Len())
+	return w.Buffer.Write(p[:n])
+}
+
+func TestByteRingByteReaderWriter(t *testing.T) {
+	r := collections.NewByteRing(3)
+	require.NoError(t, r.WriteByte('a'))
+	require.NoError(t, r.WriteByte('b'))
+	require.NoError(t, r.WriteByte('c'))
+	require.ErrorIs(t, r.WriteByte('d'), io.ErrShortWrite)
+
+	for _, expect := range []byte("abc") {
+		c, err := r.ReadByte()
+		require.NoError(t, err)
+		require.Equal(t, expect, c)
+	}
+	_, err := r.ReadByte()
+	require.ErrorIs(t, err, io.EOF)
+
+	// Works with encoding/binary varints.
+	vr := collections.NewByteRing(16)
+	_, err = vr.Write(binary.AppendUvarint(nil, 300))
+	require.NoError(t, err)
+	v, err := binary.ReadUvarint(vr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), v)
+}
+
+func TestByteRingBytes(t *testing.T) {
+	r := collections.NewByteRing(8)
+	require.Empty(t, r.Bytes())
+
+	_, _ = r.WriteString("abcdef")
+	for range 4 {
+		r.PopFront()
+	}
+	require.Equal(t, "ef", string(r.Bytes()))
+
+	_, _ = r.WriteString("ghij") // wraps around.
+	require.Equal(t, "efghij", string(r.Bytes()))
+
+	// The ring continues to work after being made contiguous.
+	n, err := r.WriteString("klm")
+	require.ErrorIs(t, err, io.ErrShortWrite)
+	require.Equal(t, 2, n)
+	require.Equal(t, "efghijkl", string(r.Bytes()))
+}
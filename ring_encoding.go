@@ -0,0 +1,69 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// MarshalJSON encodes the elements of the ring as a JSON array, in order.
+// The capacity of the ring is not encoded.
+func (r *Ring[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.ToSlice())
+}
+
+// UnmarshalJSON decodes a JSON array into the ring, replacing any existing
+// elements. The backing slice is replaced, so the capacity of the ring becomes
+// the number of decoded elements, and the ring is full.
+func (r *Ring[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	r.setElements(slices.Clip(elements))
+	return nil
+}
+
+// gobRing is the gob encoding of a ring.
+type gobRing[T any] struct {
+	Cap      int
+	Elements []T
+}
+
+// GobEncode encodes the capacity and the elements of the ring, in order.
+func (r *Ring[T]) GobEncode() ([]byte, error) {
+	g := gobRing[T]{Cap: r.Cap(), Elements: r.ToSlice()}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(g); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes a ring encoded by GobEncode, replacing any existing
+// elements. The decoded ring has the same capacity and elements as the encoded
+// ring, with the elements contiguous at the start of a new backing slice.
+func (r *Ring[T]) GobDecode(data []byte) error {
+	var g gobRing[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&g); err != nil {
+		return err
+	}
+	if g.Cap < len(g.Elements) {
+		return fmt.Errorf("capacity %d is too small to hold %d elements", g.Cap, len(g.Elements))
+	}
+	elements := make([]T, g.Cap)
+	copy(elements, g.Elements)
+	r.setElements(elements)
+	r.right = elements[:len(g.Elements)]
+	return nil
+}
+
+// setElements replaces the contents of the ring with the given elements, using
+// the slice as the new backing slice, so that the ring is full.
+func (r *Ring[T]) setElements(elements []T) {
+	r.elements = elements
+	r.right = elements
+	r.left = elements[:0]
+}
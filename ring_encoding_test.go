@@ -0,0 +1,74 @@
+package collections_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arg0net/collections"
+)
+
+func TestRingJSON(t *testing.T) {
+	r := collections.NewRing[int](4)
+	data, err := json.Marshal(r)
+	require.NoError(t, err)
+	require.JSONEq(t, `[]`, string(data))
+
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	data, err = json.Marshal(r)
+	require.NoError(t, err)
+	require.JSONEq(t, `[1, 2, 3, 4]`, string(data))
+
+	decoded := collections.NewRing[int](10)
+	decoded.PushBack(99)
+	require.NoError(t, json.Unmarshal(data, decoded))
+	require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(decoded.All()))
+	require.Equal(t, 4, decoded.Cap())
+
+	// The decoded ring is usable.
+	require.False(t, decoded.PushBack(5))
+	v, ok := decoded.PopFront()
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+	require.True(t, decoded.PushBack(5))
+	require.Equal(t, []int{2, 3, 4, 5}, slices.Collect(decoded.All()))
+
+	require.Error(t, json.Unmarshal([]byte(`{}`), decoded))
+}
+
+func TestRingGob(t *testing.T) {
+	r := collections.NewRing[string](5)
+	for _, v := range []string{"a", "b", "c", "d"} {
+		r.PushBack(v)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack("e")
+	r.PushBack("f") // c,d,e,f wrapped.
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(r))
+
+	decoded := collections.NewRing[string](1)
+	decoded.PushBack("z")
+	require.NoError(t, gob.NewDecoder(&buf).Decode(decoded))
+	require.Equal(t, 5, decoded.Cap())
+	require.Equal(t, []string{"c", "d", "e", "f"}, slices.Collect(decoded.All()))
+
+	// The decoded ring is usable.
+	require.True(t, decoded.PushBack("g"))
+	require.False(t, decoded.PushBack("h"))
+	v, ok := decoded.PopFront()
+	require.True(t, ok)
+	require.Equal(t, "c", v)
+	require.Equal(t, []string{"d", "e", "f", "g"}, slices.Collect(decoded.All()))
+}
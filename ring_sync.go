@@ -0,0 +1,96 @@
+package collections
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncRing wraps a Ring with a mutex, so that it is safe for concurrent use.
+// It provides the core methods of Ring, each of which holds the lock for the
+// duration of the call. Compound operations, which must not be interleaved
+// with other calls, can be performed under the lock with Atomic.
+type SyncRing[T any] struct {
+	mu     sync.Mutex
+	ring   *Ring[T]
+	length atomic.Int64 // length of the ring, updated under mu, see LenApprox.
+}
+
+// NewSyncRing creates a new SyncRing which wraps the given ring. The ring must
+// not be accessed directly afterwards, except within Atomic.
+func NewSyncRing[T any](r *Ring[T]) *SyncRing[T] {
+	s := &SyncRing[T]{ring: r}
+	s.length.Store(int64(r.Len()))
+	return s
+}
+
+// PushBack adds the element to the ring. If the ring is full, it returns false.
+func (s *SyncRing[T]) PushBack(e T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.updateLength()
+	return s.ring.PushBack(e)
+}
+
+// PopFront removes and returns the first element in the ring.
+// If the ring is empty, it returns false.
+func (s *SyncRing[T]) PopFront() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.updateLength()
+	return s.ring.PopFront()
+}
+
+// PeekFront returns the first element in the ring without removing it.
+func (s *SyncRing[T]) PeekFront() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ring.PeekFront()
+}
+
+// Skip removes up to n elements from the front of the ring, and returns the
+// number of elements removed.
+func (s *SyncRing[T]) Skip(n int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.updateLength()
+	return s.ring.Skip(n)
+}
+
+// Len returns the number of elements in the ring.
+func (s *SyncRing[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ring.Len()
+}
+
+// Copy makes a copy of the first elements of the ring into the out slice.
+// It returns the number of elements copied.
+func (s *SyncRing[T]) Copy(out []T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ring.Copy(out)
+}
+
+// Atomic calls fn with the wrapped ring while holding the lock, so that fn
+// can perform several operations without other calls interleaving.
+// The function must not call methods of the SyncRing, which would deadlock,
+// nor retain the ring after returning.
+func (s *SyncRing[T]) Atomic(fn func(r *Ring[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.updateLength()
+	fn(s.ring)
+}
+
+// LenApprox returns the number of elements in the ring without acquiring the
+// lock, which makes it cheap enough for high-frequency metrics. The result may
+// be stale, since it does not reflect operations which are in progress.
+func (s *SyncRing[T]) LenApprox() int {
+	return int(s.length.Load())
+}
+
+// updateLength records the length of the ring for LenApprox. It must be called
+// with the lock held.
+func (s *SyncRing[T]) updateLength() {
+	s.length.Store(int64(s.ring.Len()))
+}
@@ -0,0 +1,103 @@
+package collections_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arg0net/collections"
+)
+
+func TestSyncRing(t *testing.T) {
+	s := collections.NewSyncRing(collections.NewRing[int](3))
+	_, ok := s.PopFront()
+	require.False(t, ok)
+
+	require.True(t, s.PushBack(1))
+	require.True(t, s.PushBack(2))
+	require.True(t, s.PushBack(3))
+	require.False(t, s.PushBack(4))
+	require.Equal(t, 3, s.Len())
+
+	el, ok := s.PeekFront()
+	require.True(t, ok)
+	require.Equal(t, 1, el)
+
+	buf := make([]int, 3)
+	require.Equal(t, 3, s.Copy(buf))
+	require.Equal(t, []int{1, 2, 3}, buf)
+
+	require.Equal(t, 2, s.Skip(2))
+	el, ok = s.PopFront()
+	require.True(t, ok)
+	require.Equal(t, 3, el)
+	require.Equal(t, 0, s.Len())
+}
+
+func TestSyncRing_LenApprox(t *testing.T) {
+	r := collections.NewRing[int](4)
+	r.PushBack(1)
+	s := collections.NewSyncRing(r)
+	require.Equal(t, 1, s.LenApprox())
+
+	s.PushBack(2)
+	s.PushBack(3)
+	require.Equal(t, 3, s.LenApprox())
+	s.PopFront()
+	require.Equal(t, 2, s.LenApprox())
+	s.Atomic(func(r *collections.Ring[int]) {
+		r.PushBack(4)
+		r.PushBack(5)
+	})
+	require.Equal(t, 4, s.LenApprox())
+	s.Skip(3)
+	require.Equal(t, 1, s.LenApprox())
+}
+
+func TestSyncRing_Concurrent(t *testing.T) {
+	const writers, perWriter = 4, 100
+	s := collections.NewSyncRing(collections.NewRing[int](writers * perWriter))
+
+	pushed := make(chan int, writers)
+	for range writers {
+		go func() {
+			var n int
+			for i := range perWriter {
+				if s.PushBack(i) {
+					n++
+				}
+			}
+			pushed <- n
+		}()
+	}
+	for range writers {
+		require.Equal(t, perWriter, <-pushed)
+	}
+	require.Equal(t, writers*perWriter, s.Len())
+
+	// Atomic pops pairs without other calls interleaving.
+	var popped int
+	var wg sync.WaitGroup
+	for range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				var n int
+				s.Atomic(func(r *collections.Ring[int]) {
+					if r.Len() >= 2 {
+						n = r.Skip(2)
+						popped += n
+					}
+				})
+				if n == 0 {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, writers*perWriter, popped)
+	require.Equal(t, 0, s.Len())
+}
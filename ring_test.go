@@ -1,8 +1,12 @@
 package collections_test
 
 import (
+	"context"
+	"fmt"
 	"slices"
+	"strconv"
 	"testing"
+	"time"
 
 	fuzz "github.com/AdaLogics/go-fuzz-headers"
 	"github.com/stretchr/testify/require"
@@ -102,48 +106,1215 @@ func TestRingIndex(t *testing.T) {
 	require.Equal(t, []int{2, 4, 6, 8, 0}, buf)
 }
 
+func TestRingIndex_Negative(t *testing.T) {
+	r := collections.NewRing[int](4)
+	_, ok := r.PeekIndex(-1)
+	require.False(t, ok)
+	_, ok = r.PopIndex(-1)
+	require.False(t, ok)
+
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	el, ok := r.PeekIndex(-1)
+	require.True(t, ok)
+	require.Equal(t, 4, el)
+	el, ok = r.PeekIndex(-4)
+	require.True(t, ok)
+	require.Equal(t, 1, el)
+	_, ok = r.PeekIndex(-5)
+	require.False(t, ok)
+
+	el, ok = r.PopIndex(-2)
+	require.True(t, ok)
+	require.Equal(t, 3, el)
+	el, ok = r.PopIndex(-3)
+	require.True(t, ok)
+	require.Equal(t, 1, el)
+	_, ok = r.PopIndex(-3)
+	require.False(t, ok)
+	require.Equal(t, []int{2, 4}, slices.Collect(r.All()))
+}
+
 func TestRingIndex_Wrap(t *testing.T) {
 	r := collections.NewRing[int](3)
 	r.PushBack(1)
 	r.PushBack(2)
 	r.PushBack(3)
-	el, ok := r.PopIndex(2)
-	require.True(t, ok)
-	require.Equal(t, 3, el)
-	r.PushBack(4)
-	el, ok = r.PopIndex(1)
-	require.True(t, ok)
-	require.Equal(t, 2, el)
-	r.PushBack(5)
-	el, ok = r.PopIndex(0)
-	require.True(t, ok)
-	require.Equal(t, 1, el)
-	r.PushBack(6)
-	el, ok = r.PopIndex(2)
-	require.True(t, ok)
-	require.Equal(t, 6, el)
+	el, ok := r.PopIndex(2)
+	require.True(t, ok)
+	require.Equal(t, 3, el)
+	r.PushBack(4)
+	el, ok = r.PopIndex(1)
+	require.True(t, ok)
+	require.Equal(t, 2, el)
+	r.PushBack(5)
+	el, ok = r.PopIndex(0)
+	require.True(t, ok)
+	require.Equal(t, 1, el)
+	r.PushBack(6)
+	el, ok = r.PopIndex(2)
+	require.True(t, ok)
+	require.Equal(t, 6, el)
+}
+
+func TestRingFromSlice(t *testing.T) {
+	data := []int{1, 2, 3}
+	r := collections.RingFromSlice(data, 0)
+	require.Equal(t, 3, r.Len())
+	require.Equal(t, 3, r.Cap())
+	require.Equal(t, []int{1, 2, 3}, slices.Collect(r.All()))
+	require.False(t, r.PushBack(4))
+
+	r = collections.RingFromSlice(data, 2)
+	require.Equal(t, 5, r.Cap())
+	require.True(t, r.PushBack(4))
+	require.True(t, r.PushBack(5))
+	require.False(t, r.PushBack(6))
+	require.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(r.All()))
+
+	// The ring does not alias the slice.
+	r.SetIndex(0, 10)
+	require.Equal(t, 1, data[0])
+
+	r = collections.RingFromSlice[int](nil, 1)
+	require.Equal(t, 0, r.Len())
+	require.True(t, r.PushBack(1))
+}
+
+func TestRingFilled(t *testing.T) {
+	r := collections.NewRingFilled(3, 7)
+	require.Equal(t, 3, r.Len())
+	require.Equal(t, 3, r.Cap())
+	require.Equal(t, []int{7, 7, 7}, slices.Collect(r.All()))
+
+	evicted, ok := r.PushBackEvict(1)
+	require.True(t, ok)
+	require.Equal(t, 7, evicted)
+	require.Equal(t, []int{7, 7, 1}, slices.Collect(r.All()))
+
+	require.Equal(t, 0, collections.NewRingFilled(0, 7).Len())
+}
+
+func TestRingCentered(t *testing.T) {
+	r := collections.NewRingCentered[int](5)
+	require.Equal(t, 0, r.Len())
+	require.Equal(t, 5, r.Cap())
+
+	_, ok := r.PopFront()
+	require.False(t, ok)
+
+	for i := 1; i <= 5; i++ {
+		require.True(t, r.PushBack(i))
+	}
+	require.False(t, r.PushBack(6))
+	require.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(r.All()))
+
+	for i := 1; i <= 3; i++ {
+		el, ok := r.PopFront()
+		require.True(t, ok)
+		require.Equal(t, i, el)
+	}
+	require.True(t, r.PushBack(6))
+	require.True(t, r.PushBack(7))
+	require.True(t, r.PushBack(8))
+	require.False(t, r.PushBack(9))
+	require.Equal(t, []int{4, 5, 6, 7, 8}, slices.Collect(r.All()))
+
+	for i := 4; i <= 8; i++ {
+		el, ok := r.PopFront()
+		require.True(t, ok)
+		require.Equal(t, i, el)
+	}
+	require.Equal(t, 0, r.Len())
+}
+
+func TestRingCompacting(t *testing.T) {
+	r := collections.NewRingCompacting[int](8)
+	var next, expect int
+	for range 8 {
+		require.True(t, r.PushBack(next))
+		next++
+	}
+
+	// Steady churn, checking the contents remain correct as the ring wraps
+	// and is compacted.
+	for range 100 {
+		for range 3 {
+			v, ok := r.PopFront()
+			require.True(t, ok)
+			require.Equal(t, expect, v)
+			expect++
+		}
+		for range 3 {
+			require.True(t, r.PushBack(next))
+			next++
+		}
+		require.Equal(t, 8, r.Len())
+		require.Equal(t, []int{expect, expect + 1, expect + 2, expect + 3,
+			expect + 4, expect + 5, expect + 6, expect + 7}, slices.Collect(r.All()))
+	}
+}
+
+func TestRingNormalize(t *testing.T) {
+	r := collections.NewRing[int](5)
+	for i := 0; i < 5; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(5) // 2,3,4,5 wrapped.
+
+	r.Normalize()
+	require.Equal(t, []int{2, 3, 4, 5}, slices.Collect(r.All()))
+	require.Equal(t, 5, r.Cap())
+	first, _ := r.PeekFront()
+	require.Equal(t, 2, first)
+
+	// The ring continues to work after normalizing.
+	require.True(t, r.PushBack(6))
+	require.False(t, r.PushBack(7))
+	require.Equal(t, []int{2, 3, 4, 5, 6}, slices.Collect(r.All()))
+
+	// Contiguous, but not at the start of the backing slice.
+	for range 3 {
+		r.PopFront()
+	}
+	r.Normalize()
+	require.Equal(t, []int{5, 6}, slices.Collect(r.All()))
+	for i := 7; i < 10; i++ {
+		require.True(t, r.PushBack(i))
+	}
+	require.False(t, r.PushBack(10))
+	require.Equal(t, []int{5, 6, 7, 8, 9}, slices.Collect(r.All()))
+
+	r.Normalize() // no-op
+	require.Equal(t, []int{5, 6, 7, 8, 9}, slices.Collect(r.All()))
+}
+
+func TestRingPushBackSeq(t *testing.T) {
+	r := collections.NewRing[int](4)
+	require.Equal(t, 2, r.PushBackSeq(slices.Values([]int{1, 2})))
+
+	var pulled int
+	seq := func(yield func(int) bool) {
+		for i := 3; i < 10; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	require.Equal(t, 2, r.PushBackSeq(seq))
+	require.Equal(t, 2, pulled, "no values pulled after the ring is full")
+	require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(r.All()))
+
+	pulled = 0
+	require.Equal(t, 0, r.PushBackSeq(seq))
+	require.Equal(t, 0, pulled)
+}
+
+func TestRingMoveFrom(t *testing.T) {
+	src := collections.NewRing[int](5)
+	for i := 0; i < 5; i++ {
+		src.PushBack(i)
+	}
+	src.PopFront()
+	src.PopFront()
+	src.PushBack(5)
+	src.PushBack(6) // 2..6 wrapped.
+
+	dst := collections.NewRing[int](4)
+	dst.PushBack(0)
+	dst.PushBack(1)
+	dst.PopFront() // 1, with space on both sides.
+
+	require.Equal(t, 2, dst.MoveFrom(src, 2))
+	require.Equal(t, []int{1, 2, 3}, slices.Collect(dst.All()))
+	require.Equal(t, []int{4, 5, 6}, slices.Collect(src.All()))
+
+	// Stops when the destination is full.
+	require.Equal(t, 1, dst.MoveFrom(src, 10))
+	require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(dst.All()))
+	require.Equal(t, []int{5, 6}, slices.Collect(src.All()))
+	require.Equal(t, 0, dst.MoveFrom(src, 10))
+
+	// Stops when the source is empty, and wraps the destination.
+	dst.PopFront()
+	dst.PopFront()
+	require.Equal(t, 2, dst.MoveFrom(src, 10))
+	require.Equal(t, []int{3, 4, 5, 6}, slices.Collect(dst.All()))
+	require.Equal(t, 0, src.Len())
+	require.Equal(t, 0, dst.MoveFrom(src, 10))
+}
+
+func TestRingPushFront(t *testing.T) {
+	r := collections.NewRing[int](4)
+	require.True(t, r.PushFront(2)) // wraps around to the end.
+	require.True(t, r.PushFront(1))
+	require.True(t, r.PushBack(3))
+	require.True(t, r.PushBack(4))
+	require.False(t, r.PushFront(0))
+	require.False(t, r.PushBack(5))
+	require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(r.All()))
+
+	for i := 1; i <= 4; i++ {
+		el, ok := r.PopFront()
+		require.True(t, ok)
+		require.Equal(t, i, el)
+	}
+
+	// Centered rings can push onto both ends without wrapping.
+	r = collections.NewRingCentered[int](4)
+	require.True(t, r.PushFront(2))
+	require.True(t, r.PushFront(1))
+	require.True(t, r.PushBack(3))
+	require.True(t, r.PushFront(0))
+	require.False(t, r.PushFront(-1))
+	require.Equal(t, []int{0, 1, 2, 3}, slices.Collect(r.All()))
+	for i := 0; i <= 3; i++ {
+		el, ok := r.PeekIndex(i)
+		require.True(t, ok)
+		require.Equal(t, i, el)
+	}
+}
+
+func TestRingPopBack(t *testing.T) {
+	r := collections.NewRing[int](3)
+	_, ok := r.PopBack()
+	require.False(t, ok)
+
+	r.PushBack(1)
+	r.PushBack(2)
+	r.PushBack(3)
+	r.PopFront()
+	r.PushBack(4) // 2,3,4 wrapped.
+
+	el, ok := r.PeekBack()
+	require.True(t, ok)
+	require.Equal(t, 4, el)
+	require.Equal(t, 3, r.Len())
+
+	for _, expect := range []int{4, 3} {
+		el, ok := r.PopBack()
+		require.True(t, ok)
+		require.Equal(t, expect, el)
+	}
+	require.True(t, r.PushBack(5))
+	require.True(t, r.PushBack(6))
+	require.False(t, r.PushBack(7))
+	require.Equal(t, []int{2, 5, 6}, slices.Collect(r.All()))
+	el, ok = r.PeekBack()
+	require.True(t, ok)
+	require.Equal(t, 6, el)
+
+	for _, expect := range []int{6, 5, 2} {
+		el, ok := r.PopBack()
+		require.True(t, ok)
+		require.Equal(t, expect, el)
+	}
+	_, ok = r.PopBack()
+	require.False(t, ok)
+	_, ok = r.PeekBack()
+	require.False(t, ok)
+	require.Equal(t, 0, r.Len())
+}
+
+func TestRingResetNoZero(t *testing.T) {
+	r := collections.NewRing[int](3)
+	for i := 0; i < 3; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(3) // 1,2,3 wrapped.
+
+	r.ResetNoZero()
+	require.Equal(t, 0, r.Len())
+	require.Equal(t, 3, r.Cap())
+	_, ok := r.PeekFront()
+	require.False(t, ok)
+
+	for i := 4; i < 7; i++ {
+		require.True(t, r.PushBack(i))
+	}
+	require.False(t, r.PushBack(7))
+	require.Equal(t, []int{4, 5, 6}, slices.Collect(r.All()))
+}
+
+func TestRingSkip(t *testing.T) {
+	r := collections.NewRing[int](4)
+	require.Equal(t, 0, r.Skip(1))
+
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	require.Equal(t, 0, r.Skip(-1))
+	require.Equal(t, 3, r.Skip(3))
+	require.Equal(t, []int{4}, slices.Collect(r.All()))
+	require.Equal(t, 1, r.Skip(3))
+	require.Equal(t, 0, r.Len())
+}
+
+func TestRingDropBack(t *testing.T) {
+	wrapped := func() *collections.Ring[int] {
+		r := collections.NewRing[int](4)
+		for i := 0; i < 4; i++ {
+			r.PushBack(i)
+		}
+		r.PopFront()
+		r.PopFront()
+		r.PushBack(4)
+		r.PushBack(5) // 2,3,4,5 wrapped.
+		return r
+	}
+
+	for _, tc := range []struct {
+		name   string
+		n      int
+		expect []int
+	}{
+		{"none", 0, []int{2, 3, 4, 5}},
+		{"negative", -1, []int{2, 3, 4, 5}},
+		{"within left", 1, []int{2, 3, 4}},
+		{"all of left", 2, []int{2, 3}},
+		{"into right", 3, []int{2}},
+		{"all", 4, nil},
+		{"more than len", 5, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := wrapped()
+			r.DropBack(tc.n)
+			require.Equal(t, tc.expect, slices.Collect(r.All()))
+
+			// The freed slots can be reused.
+			require.Equal(t, len(tc.expect) < 4, r.PushBack(6))
+			if len(tc.expect) < 4 {
+				require.Equal(t, append(tc.expect, 6), slices.Collect(r.All()))
+			}
+		})
+	}
+}
+
+func TestRingSplitAt(t *testing.T) {
+	wrapped := func() *collections.Ring[int] {
+		r := collections.NewRing[int](5)
+		for i := 0; i < 5; i++ {
+			r.PushBack(i)
+		}
+		r.PopFront()
+		r.PopFront()
+		r.PushBack(5)
+		r.PushBack(6) // 2,3,4,5,6 wrapped.
+		return r
+	}
+
+	for _, tc := range []struct {
+		name       string
+		i          int
+		head, tail []int
+	}{
+		{"start", 0, nil, []int{2, 3, 4, 5, 6}},
+		{"within right", 1, []int{2}, []int{3, 4, 5, 6}},
+		{"at wrap", 3, []int{2, 3, 4}, []int{5, 6}},
+		{"within left", 4, []int{2, 3, 4, 5}, []int{6}},
+		{"end", 5, []int{2, 3, 4, 5, 6}, nil},
+		{"past end", 6, []int{2, 3, 4, 5, 6}, nil},
+		{"negative", -1, nil, []int{2, 3, 4, 5, 6}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := wrapped()
+			tail := r.SplitAt(tc.i)
+			require.Equal(t, tc.head, slices.Collect(r.All()))
+			require.Equal(t, tc.tail, slices.Collect(tail.All()))
+			require.Equal(t, 5, tail.Cap())
+
+			// Both rings remain usable.
+			if r.Len() < r.Cap() {
+				require.True(t, r.PushBack(7))
+			}
+			if tail.Len() < tail.Cap() {
+				require.True(t, tail.PushBack(7))
+			}
+		})
+	}
+}
+
+func TestRingPopN(t *testing.T) {
+	r := collections.NewRing[int](4)
+	out := make([]int, 3)
+	require.Equal(t, 0, r.PopN(out))
+
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	require.Equal(t, 3, r.PopN(out))
+	require.Equal(t, []int{1, 2, 3}, out)
+	require.Equal(t, []int{4}, slices.Collect(r.All()))
+
+	require.Equal(t, 1, r.PopN(out))
+	require.Equal(t, 4, out[0])
+	require.Equal(t, 0, r.Len())
+	require.Equal(t, 0, r.PopN(out))
+}
+
+func TestRingSwapRemove(t *testing.T) {
+	r := collections.NewRing[int](4)
+	_, ok := r.SwapRemove(0)
+	require.False(t, ok)
+
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	el, ok := r.SwapRemove(0)
+	require.True(t, ok)
+	require.Equal(t, 1, el)
+	require.Equal(t, []int{4, 2, 3}, slices.Collect(r.All()))
+
+	el, ok = r.SwapRemove(2)
+	require.True(t, ok)
+	require.Equal(t, 3, el)
+	require.Equal(t, []int{4, 2}, slices.Collect(r.All()))
+
+	_, ok = r.SwapRemove(2)
+	require.False(t, ok)
+	_, ok = r.SwapRemove(-1)
+	require.False(t, ok)
+}
+
+func TestRingPushBackEvict(t *testing.T) {
+	r := collections.NewRing[int](3)
+	for i := 1; i <= 3; i++ {
+		_, evicted := r.PushBackEvict(i)
+		require.False(t, evicted)
+	}
+	for i := 4; i <= 7; i++ {
+		el, evicted := r.PushBackEvict(i)
+		require.True(t, evicted)
+		require.Equal(t, i-3, el)
+	}
+	require.Equal(t, []int{5, 6, 7}, slices.Collect(r.All()))
+
+	r.PopBack()
+	el, evicted := r.PushBackEvict(8)
+	require.False(t, evicted)
+	require.Equal(t, 0, el)
+	require.Equal(t, []int{5, 6, 8}, slices.Collect(r.All()))
+
+	// A zero-sized ring can't hold the element, even after evicting.
+	empty := collections.NewRing[int](0)
+	_, evicted = empty.PushBackEvict(1)
+	require.False(t, evicted)
+	require.Equal(t, 0, empty.Len())
+}
+
+func TestRingSetIndex(t *testing.T) {
+	r := collections.NewRing[int](3)
+	require.False(t, r.SetIndex(0, 1))
+	r.PushBack(1)
+	r.PushBack(2)
+	r.PushBack(3)
+	r.PopFront()
+	r.PushBack(4) // 2,3,4 wrapped.
+
+	require.True(t, r.SetIndex(0, 20))
+	require.True(t, r.SetIndex(2, 40))
+	require.False(t, r.SetIndex(3, 50))
+	require.False(t, r.SetIndex(-1, 50))
+	require.Equal(t, []int{20, 3, 40}, slices.Collect(r.All()))
+}
+
+func TestRingReplaceIndex(t *testing.T) {
+	r := collections.NewRing[int](3)
+	_, ok := r.ReplaceIndex(0, 1)
+	require.False(t, ok)
+	r.PushBack(1)
+	r.PushBack(2)
+	r.PushBack(3)
+	r.PopFront()
+	r.PushBack(4) // 2,3,4 wrapped.
+
+	old, ok := r.ReplaceIndex(0, 20)
+	require.True(t, ok)
+	require.Equal(t, 2, old)
+	old, ok = r.ReplaceIndex(2, 40)
+	require.True(t, ok)
+	require.Equal(t, 4, old)
+	_, ok = r.ReplaceIndex(3, 50)
+	require.False(t, ok)
+	require.Equal(t, []int{20, 3, 40}, slices.Collect(r.All()))
+}
+
+func TestRingSwap(t *testing.T) {
+	r := collections.NewRing[int](4)
+	require.False(t, r.Swap(0, 0))
+
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	require.True(t, r.Swap(0, 3))
+	require.Equal(t, []int{4, 2, 3, 1}, slices.Collect(r.All()))
+	require.True(t, r.Swap(1, 1))
+	require.Equal(t, []int{4, 2, 3, 1}, slices.Collect(r.All()))
+	require.False(t, r.Swap(0, 4))
+	require.False(t, r.Swap(-1, 0))
+	require.Equal(t, []int{4, 2, 3, 1}, slices.Collect(r.All()))
+}
+
+func TestRingInsert(t *testing.T) {
+	r := collections.NewRing[int](6)
+	require.False(t, r.Insert(1, 0))
+	require.True(t, r.Insert(0, 2))
+	require.True(t, r.Insert(1, 4))
+	require.True(t, r.Insert(0, 0))
+	require.True(t, r.Insert(1, 1))
+	require.True(t, r.Insert(3, 3))
+	require.True(t, r.Insert(5, 5))
+	require.False(t, r.Insert(0, 6))
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5}, slices.Collect(r.All()))
+
+	// Insert into a wrapped ring, in both directions.
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(6) // 2,3,4,5,6 wrapped.
+	require.True(t, r.Insert(1, 20))
+	require.Equal(t, []int{2, 20, 3, 4, 5, 6}, slices.Collect(r.All()))
+	r.PopIndex(1)
+	require.True(t, r.Insert(4, 50))
+	require.Equal(t, []int{2, 3, 4, 5, 50, 6}, slices.Collect(r.All()))
+	require.False(t, r.Insert(7, 7))
+}
+
+func TestRingClone(t *testing.T) {
+	r := collections.NewRing[int](4)
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	c := r.Clone()
+	require.Equal(t, 4, c.Cap())
+	require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(c.All()))
+
+	// Changes to the clone don't affect the original, and vice versa.
+	c.PopFront()
+	c.SetIndex(0, 20)
+	require.True(t, c.PushBack(5))
+	r.SetIndex(3, 40)
+	require.Equal(t, []int{20, 3, 4, 5}, slices.Collect(c.All()))
+	require.Equal(t, []int{1, 2, 3, 40}, slices.Collect(r.All()))
+}
+
+func TestRingReverse(t *testing.T) {
+	r := collections.NewRing[int](5)
+	r.Reverse()
+	require.Equal(t, 0, r.Len())
+
+	for i := 0; i < 5; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(5) // 2,3,4,5 wrapped.
+
+	r.Reverse()
+	require.Equal(t, []int{5, 4, 3, 2}, slices.Collect(r.All()))
+	require.True(t, r.PushBack(1))
+	require.False(t, r.PushBack(0))
+	el, _ := r.PopFront()
+	require.Equal(t, 5, el)
+	require.Equal(t, []int{4, 3, 2, 1}, slices.Collect(r.All()))
+}
+
+func TestRingRotate(t *testing.T) {
+	r := collections.NewRing[int](5)
+	r.Rotate(3)
+	require.Equal(t, 0, r.Len())
+
+	// Full ring, which only moves the start.
+	for i := 0; i < 5; i++ {
+		r.PushBack(i)
+	}
+	r.Rotate(2)
+	require.Equal(t, []int{2, 3, 4, 0, 1}, slices.Collect(r.All()))
+	r.Rotate(-1)
+	require.Equal(t, []int{1, 2, 3, 4, 0}, slices.Collect(r.All()))
+	r.Rotate(10)
+	require.Equal(t, []int{1, 2, 3, 4, 0}, slices.Collect(r.All()))
+	el, _ := r.PopFront()
+	require.Equal(t, 1, el)
+	require.True(t, r.PushBack(5))
+	require.False(t, r.PushBack(6))
+	require.Equal(t, []int{2, 3, 4, 0, 5}, slices.Collect(r.All()))
+
+	// Partially full ring, in both directions.
+	r.PopFront()
+	r.Rotate(1)
+	require.Equal(t, []int{4, 0, 5, 3}, slices.Collect(r.All()))
+	r.Rotate(3)
+	require.Equal(t, []int{3, 4, 0, 5}, slices.Collect(r.All()))
+	r.Rotate(-6)
+	require.Equal(t, []int{0, 5, 3, 4}, slices.Collect(r.All()))
+	require.True(t, r.PushBack(6))
+	require.False(t, r.PushBack(7))
+	require.Equal(t, []int{0, 5, 3, 4, 6}, slices.Collect(r.All()))
+}
+
+func TestRingDynamic(t *testing.T) {
+	r := collections.NewDynamicRing[int](2)
+	for i := 0; i < 2; i++ {
+		require.True(t, r.PushBack(i))
+	}
+	r.PopFront()
+	require.True(t, r.PushBack(2)) // 1,2 wrapped.
+	require.Equal(t, 2, r.Cap())
+
+	// Growing preserves the order of a wrapped ring.
+	require.True(t, r.PushBack(3))
+	require.Equal(t, 4, r.Cap())
+	require.True(t, r.PushFront(0))
+	require.True(t, r.PushBack(4))
+	require.Equal(t, 8, r.Cap())
+	require.True(t, r.Insert(2, 10))
+	require.Equal(t, []int{0, 1, 10, 2, 3, 4}, slices.Collect(r.All()))
+
+	for i := 5; i < 100; i++ {
+		require.True(t, r.PushBack(i))
+	}
+	require.Equal(t, 101, r.Len())
+	require.Equal(t, 128, r.Cap())
+
+	// A zero-sized dynamic ring grows too.
+	z := collections.NewDynamicRing[int](0)
+	require.True(t, z.PushFront(1))
+	require.Equal(t, 1, z.PushBackSeq(slices.Values([]int{2})))
+	require.Equal(t, []int{1, 2}, slices.Collect(z.All()))
+}
+
+func TestRingScan(t *testing.T) {
+	r := collections.NewRing[int](7)
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	for i := 5; i < 100; i++ {
+		check := i % 4
+		value, ok := r.PeekIndex(check)
+		require.True(t, ok)
+		found, idx := r.Scan(func(v int) bool {
+			return v == value
+		})
+		require.Equal(t, value, found)
+		require.Equal(t, check, idx)
+		r.PopFront()
+		r.PushBack(i)
+	}
+
+	// Final result should be 96, 97, 98, 99
+	require.Equal(t, []int{96, 97, 98, 99}, slices.Collect(r.All()))
+}
+
+func TestRingCopyN(t *testing.T) {
+	r := collections.NewRing[int](4)
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	out := make([]int, 5)
+	require.Equal(t, 3, r.CopyN(out, 3))
+	require.Equal(t, []int{1, 2, 3, 0, 0}, out)
+	require.Equal(t, 4, r.CopyN(out, 10))
+	require.Equal(t, []int{1, 2, 3, 4, 0}, out)
+	require.Equal(t, 2, r.CopyN(out[:2], 3))
+	require.Equal(t, 0, r.CopyN(out, -1))
+	require.Equal(t, 4, r.Len())
+}
+
+func TestRingPeekRange(t *testing.T) {
+	r := collections.NewRing[int](5)
+	for i := 0; i < 5; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(5)
+	r.PushBack(6) // 2,3,4,5,6 wrapped.
+
+	out := make([]int, 5)
+	require.Equal(t, 3, r.PeekRange(1, 4, out))
+	require.Equal(t, []int{3, 4, 5}, out[:3])
+	require.Equal(t, 2, r.PeekRange(3, 10, out))
+	require.Equal(t, []int{5, 6}, out[:2])
+	require.Equal(t, 2, r.PeekRange(-2, 2, out))
+	require.Equal(t, []int{2, 3}, out[:2])
+	require.Equal(t, 2, r.PeekRange(1, 5, out[:2]))
+	require.Equal(t, []int{3, 4}, out[:2])
+	require.Equal(t, 0, r.PeekRange(3, 1, out))
+	require.Equal(t, 0, r.PeekRange(5, 6, out))
+	require.Equal(t, 5, r.Len())
+}
+
+func TestRingToSlice(t *testing.T) {
+	r := collections.NewRing[int](4)
+	require.Equal(t, []int{}, r.ToSlice())
+
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	s := r.ToSlice()
+	require.Equal(t, []int{1, 2, 3, 4}, s)
+	s[0] = 10
+	el, _ := r.PeekFront()
+	require.Equal(t, 1, el)
+}
+
+func TestRingCopyIf(t *testing.T) {
+	r := collections.NewRing[int](6)
+	for i := 0; i < 6; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(6)
+	r.PushBack(7) // 2,3,4,5,6,7 wrapped.
+
+	even := func(v int) bool { return v%2 == 0 }
+	out := make([]int, 5)
+	require.Equal(t, 3, r.CopyIf(out, even))
+	require.Equal(t, []int{2, 4, 6}, out[:3])
+
+	// Bounded by the size of out.
+	require.Equal(t, 2, r.CopyIf(out[:2], even))
+	require.Equal(t, []int{2, 4}, out[:2])
+	require.Equal(t, 6, r.Len())
+}
+
+func TestRingDrainBatch(t *testing.T) {
+	r := collections.NewRing[int](5)
+	for i := 0; i < 5; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(5)
+	r.PushBack(6) // 2,3,4,5,6 wrapped.
+
+	var batches [][]int
+	collect := func(batch []int) {
+		batches = append(batches, slices.Clone(batch))
+	}
+
+	require.Equal(t, 2, r.DrainBatch(2, collect))
+	require.Equal(t, [][]int{{2, 3}}, batches)
+
+	// The remaining elements span the wrap, so fn is called per segment.
+	batches = nil
+	require.Equal(t, 3, r.DrainBatch(10, collect))
+	require.Equal(t, [][]int{{4}, {5, 6}}, batches)
+	require.Equal(t, 0, r.Len())
+
+	batches = nil
+	require.Equal(t, 0, r.DrainBatch(10, collect))
+	require.Empty(t, batches)
+
+	// The ring remains usable after draining.
+	require.True(t, r.PushBack(7))
+	require.Equal(t, []int{7}, slices.Collect(r.All()))
+}
+
+func TestRingCopyEvery(t *testing.T) {
+	r := collections.NewRing[int](10)
+	for i := 0; i < 10; i++ {
+		r.PushBack(i)
+	}
+	for i := 0; i < 4; i++ {
+		r.PopFront()
+		r.PushBack(10 + i)
+	} // 4..13 wrapped.
+
+	out := make([]int, 10)
+	require.Equal(t, 4, r.CopyEvery(3, out))
+	require.Equal(t, []int{4, 7, 10, 13}, out[:4])
+	require.Equal(t, 5, r.CopyEvery(2, out))
+	require.Equal(t, []int{4, 6, 8, 10, 12}, out[:5])
+	require.Equal(t, 10, r.CopyEvery(1, out))
+	require.Equal(t, []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13}, out)
+	require.Equal(t, 1, r.CopyEvery(20, out))
+	require.Equal(t, 4, out[0])
+
+	// Bounded by the size of out.
+	require.Equal(t, 2, r.CopyEvery(3, out[:2]))
+	require.Equal(t, 0, r.CopyEvery(0, out))
+}
+
+func TestRingFind(t *testing.T) {
+	r := collections.NewRing[int](3)
+	r.PushBack(1)
+	r.PushBack(2)
+	r.PushBack(3)
+	r.PopFront()
+	r.PushBack(4) // 2,3,4 wrapped.
+
+	v, ok := r.Find(func(v int) bool { return v > 3 })
+	require.True(t, ok)
+	require.Equal(t, 4, v)
+
+	v, ok = r.Find(func(v int) bool { return v > 10 })
+	require.False(t, ok)
+	require.Equal(t, 0, v)
+}
+
+func TestRingChunks(t *testing.T) {
+	r := collections.NewRing[int](7)
+	for i := 0; i < 7; i++ {
+		r.PushBack(i)
+	}
+	for i := 0; i < 3; i++ {
+		r.PopFront()
+		r.PushBack(7 + i)
+	} // 3..9 wrapped.
+
+	require.Equal(t, [][]int{{3, 4, 5}, {6, 7, 8}, {9}}, slices.Collect(r.Chunks(3)))
+	require.Equal(t, [][]int{{3, 4, 5, 6, 7, 8, 9}}, slices.Collect(r.Chunks(10)))
+	require.Empty(t, slices.Collect(r.Chunks(0)))
+	require.Equal(t, 7, r.Len())
+
+	// Draining stops early, leaving the remaining elements.
+	for chunk := range r.DrainChunks(2) {
+		require.Equal(t, []int{3, 4}, chunk)
+		break
+	}
+	require.Equal(t, []int{5, 6, 7, 8, 9}, slices.Collect(r.All()))
+	require.Equal(t, [][]int{{5, 6}, {7, 8}, {9}}, slices.Collect(r.DrainChunks(2)))
+	require.Equal(t, 0, r.Len())
+}
+
+func TestRingDrainToChan(t *testing.T) {
+	r := collections.NewRing[int](4)
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+
+	out := make(chan int, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan int)
+	go func() {
+		done <- r.DrainToChan(ctx, out)
+	}()
+
+	// Receive one value, then cancel while blocked on the full channel.
+	require.Equal(t, 0, <-out)
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	require.Equal(t, 3, <-done)
+	require.Equal(t, []int{3}, slices.Collect(r.All()))
+
+	out = make(chan int, 1)
+	require.Equal(t, 1, r.DrainToChan(context.Background(), out))
+	require.Equal(t, 3, <-out)
+	require.Equal(t, 0, r.Len())
+}
+
+func TestRingEqualSlice(t *testing.T) {
+	r := collections.NewRing[int](3)
+	require.True(t, collections.EqualSlice(r, nil))
+	r.PushBack(1)
+	r.PushBack(2)
+	r.PushBack(3)
+	r.PopFront()
+	r.PushBack(4) // 2,3,4 wrapped.
+
+	require.True(t, collections.EqualSlice(r, []int{2, 3, 4}))
+	require.False(t, collections.EqualSlice(r, []int{2, 3}))
+	require.False(t, collections.EqualSlice(r, []int{2, 3, 5}))
+	require.False(t, collections.EqualSlice(r, []int{1, 3, 4}))
+
+	withinOne := func(a, b int) bool {
+		return a-b <= 1 && b-a <= 1
+	}
+	require.True(t, r.EqualSlice([]int{1, 4, 5}, withinOne))
+	require.False(t, r.EqualSlice([]int{1, 4, 6}, withinOne))
+}
+
+func TestRingTrim(t *testing.T) {
+	r := collections.NewRing[int](8)
+	for _, v := range []int{0, 0, 0, 1, 2, 3, 0, 0} {
+		r.PushBack(v)
+	}
+	r.PopFront()
+	r.PushBack(0) // 0,0,1,2,3,0,0,0 wrapped.
+
+	isZero := func(v int) bool { return v == 0 }
+	require.Equal(t, 3, r.TrimBack(isZero))
+	require.Equal(t, []int{0, 0, 1, 2, 3}, slices.Collect(r.All()))
+	require.Equal(t, 2, r.TrimFront(isZero))
+	require.Equal(t, []int{1, 2, 3}, slices.Collect(r.All()))
+	require.Equal(t, 0, r.TrimFront(isZero))
+	require.Equal(t, 0, r.TrimBack(isZero))
+
+	all := func(int) bool { return true }
+	require.Equal(t, 3, r.TrimBack(all))
+	require.Equal(t, 0, r.Len())
+	require.Equal(t, 0, r.TrimFront(all))
+
+	// The ring remains usable after trimming.
+	for i := 0; i < 8; i++ {
+		require.True(t, r.PushBack(i))
+	}
+	require.False(t, r.PushBack(8))
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7}, slices.Collect(r.All()))
+}
+
+func TestRingRemoveFunc(t *testing.T) {
+	r := collections.NewRing[int](6)
+	require.Equal(t, 0, r.RemoveFunc(func(int) bool { return true }))
+
+	for i := 0; i < 6; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(6)
+	r.PushBack(7) // 2..7 wrapped.
+
+	isOdd := func(v int) bool { return v%2 == 1 }
+	require.Equal(t, 3, r.RemoveFunc(isOdd))
+	require.Equal(t, []int{2, 4, 6}, slices.Collect(r.All()))
+	require.Equal(t, 0, r.RemoveFunc(isOdd))
+
+	for _, v := range []int{8, 9, 10} {
+		require.True(t, r.PushBack(v))
+	}
+	require.False(t, r.PushBack(11))
+	require.Equal(t, []int{2, 4, 6, 8, 9, 10}, slices.Collect(r.All()))
+
+	require.Equal(t, 6, r.RemoveFunc(func(int) bool { return true }))
+	require.Equal(t, 0, r.Len())
+}
+
+func TestRingRetainFunc(t *testing.T) {
+	r := collections.NewRing[int](5)
+	for i := 0; i < 5; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(5) // 1..5 wrapped.
+
+	require.Equal(t, 3, r.RetainFunc(func(v int) bool { return v%2 == 1 }))
+	require.Equal(t, []int{1, 3, 5}, slices.Collect(r.All()))
+	require.Equal(t, 0, r.RetainFunc(func(int) bool { return false }))
+	require.Equal(t, 0, r.Len())
+}
+
+func TestRingAllIndexed(t *testing.T) {
+	r := collections.NewRing[int](4)
+	for i := 0; i < 4; i++ {
+		r.PushBack(i * 10)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(40) // 20,30,40 wrapped.
+
+	var indices, values []int
+	for i, v := range r.AllIndexed() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	require.Equal(t, []int{0, 1, 2}, indices)
+	require.Equal(t, []int{20, 30, 40}, values)
+
+	for i, v := range r.AllIndexed() {
+		require.Equal(t, 0, i)
+		require.Equal(t, 20, v)
+		break
+	}
+}
+
+func TestRingDedupFunc(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+	r := collections.NewRing[int](6)
+	require.Equal(t, 0, r.DedupFunc(eq))
+
+	for _, v := range []int{0, 0, 1, 1, 1, 2} {
+		r.PushBack(v)
+	}
+	r.PopFront()
+	r.PushBack(2) // 0,1,1,1,2,2 wrapped.
+
+	require.Equal(t, 3, r.DedupFunc(eq))
+	require.Equal(t, []int{0, 1, 2}, slices.Collect(r.All()))
+	require.Equal(t, 0, r.DedupFunc(eq))
+
+	// Arguments are passed as (current, previous), like slices.CompactFunc.
+	succ := func(a, b int) bool { return a == b+1 }
+	data := []int{1, 2, 3, 5, 4, 5}
+	r = collections.RingFromSlice(data, 0)
+	expect := slices.CompactFunc(slices.Clone(data), succ)
+	require.Equal(t, []int{1, 5, 4}, expect)
+	require.Equal(t, len(data)-len(expect), r.DedupFunc(succ))
+	require.Equal(t, expect, slices.Collect(r.All()))
+}
+
+func TestRingBackward(t *testing.T) {
+	r := collections.NewRing[int](4)
+	require.Empty(t, slices.Collect(r.Backward()))
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(4) // 2,3,4 wrapped.
+
+	require.Equal(t, []int{4, 3, 2}, slices.Collect(r.Backward()))
+	for v := range r.Backward() {
+		require.Equal(t, 4, v)
+		break
+	}
+}
+
+func TestRingDrain(t *testing.T) {
+	r := collections.NewRing[int](4)
+	require.Empty(t, slices.Collect(r.Drain()))
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	for v := range r.Drain() {
+		require.Equal(t, 1, v)
+		break
+	}
+	require.Equal(t, []int{2, 3, 4}, slices.Collect(r.All()))
+
+	require.Equal(t, []int{2, 3, 4}, slices.Collect(r.Drain()))
+	require.Equal(t, 0, r.Len())
+}
+
+func TestRingEqual(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	a := collections.NewRing[int](3)
+	b := collections.NewRingCentered[int](5)
+	require.True(t, a.Equal(b, eq))
+
+	a.PushBack(1)
+	a.PushBack(2)
+	a.PushBack(3)
+	a.PopFront()
+	a.PushBack(4) // 2,3,4 wrapped.
+	b.PushBack(2)
+	b.PushBack(3)
+	require.False(t, a.Equal(b, eq))
+
+	b.PushBack(4)
+	require.True(t, a.Equal(b, eq))
+	require.True(t, b.Equal(a, eq))
+
+	b.SetIndex(1, 30)
+	require.False(t, a.Equal(b, eq))
+}
+
+func TestRingIndexOf(t *testing.T) {
+	r := collections.NewRing[string](3)
+	r.PushBack("a")
+	r.PushBack("b")
+	r.PushBack("c")
+	r.PopFront()
+	r.PushBack("d") // b,c,d wrapped.
+
+	for i, v := range []string{"b", "c", "d"} {
+		require.Equal(t, i, collections.IndexOf(r, v))
+		require.True(t, collections.Contains(r, v))
+	}
+	require.Equal(t, -1, collections.IndexOf(r, "a"))
+	require.False(t, collections.Contains(r, "a"))
+}
+
+func TestRingContainsFunc(t *testing.T) {
+	r := collections.NewRing[int](3)
+	r.PushBack(1)
+	r.PushBack(2)
+	r.PushBack(3)
+	r.PopFront()
+	r.PushBack(4) // 2,3,4 wrapped.
+
+	var calls int
+	require.True(t, r.ContainsFunc(func(v int) bool {
+		calls++
+		return v%2 == 1
+	}))
+	require.Equal(t, 2, calls, "stops at the first match")
+	require.True(t, r.ContainsFunc(func(v int) bool { return v == 4 }))
+	require.False(t, r.ContainsFunc(func(v int) bool { return v > 4 }))
 }
 
-func TestRingScan(t *testing.T) {
-	r := collections.NewRing[int](7)
+func TestRingString(t *testing.T) {
+	r := collections.NewRing[int](5)
+	require.Equal(t, "[]/cap=5", r.String())
+
+	for i := 0; i < 5; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(5) // 2,3,4,5 wrapped.
+	require.Equal(t, "[2 3 4 5]/cap=5", r.String())
+	require.Equal(t, "[2 3 4 5]/cap=5", fmt.Sprintf("%v", r))
+}
+
+func TestRingCount(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	r := collections.NewRing[int](4)
+	require.Equal(t, 0, r.Count(isEven))
+
 	for i := 0; i < 4; i++ {
 		r.PushBack(i)
 	}
-	for i := 5; i < 100; i++ {
-		check := i % 4
-		value, ok := r.PeekIndex(check)
-		require.True(t, ok)
-		found, idx := r.Scan(func(v int) bool {
-			return v == value
-		})
-		require.Equal(t, value, found)
-		require.Equal(t, check, idx)
-		r.PopFront()
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+	require.Equal(t, 2, r.Count(isEven))
+	require.Equal(t, 4, r.Count(func(int) bool { return true }))
+	require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(r.All()))
+}
+
+func TestRingAsSlices(t *testing.T) {
+	r := collections.NewRing[int](4)
+	first, second := r.AsSlices()
+	require.Empty(t, first)
+	require.Empty(t, second)
+
+	for i := 0; i < 4; i++ {
 		r.PushBack(i)
 	}
+	first, second = r.AsSlices()
+	require.Equal(t, []int{0, 1, 2, 3}, first)
+	require.Empty(t, second)
 
-	// Final result should be 96, 97, 98, 99
-	require.Equal(t, []int{96, 97, 98, 99}, slices.Collect(r.All()))
+	r.PopFront()
+	r.PushBack(4)
+	first, second = r.AsSlices()
+	require.Equal(t, []int{1, 2, 3}, first)
+	require.Equal(t, []int{4}, second)
 }
 
 func TestRingResize(t *testing.T) {
@@ -156,6 +1327,219 @@ func TestRingResize(t *testing.T) {
 	require.NoError(t, r.Resize(5))
 	require.Equal(t, 3, r.Len())
 	require.Equal(t, 5, r.Cap())
+
+	// The ring remains usable after resizing.
+	require.True(t, r.PushBack(4))
+	require.True(t, r.PushBack(5))
+	require.False(t, r.PushBack(6))
+	require.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(r.All()))
+	el, ok := r.PopFront()
+	require.True(t, ok)
+	require.Equal(t, 1, el)
+}
+
+func TestRingGrowBy(t *testing.T) {
+	r := collections.NewRing[int](2)
+	r.PushBack(1)
+	r.PushBack(2)
+	r.PopFront()
+	r.PushBack(3) // 2,3 wrapped.
+
+	require.Error(t, r.GrowBy(-1))
+	require.Equal(t, 2, r.Cap())
+	require.NoError(t, r.GrowBy(0))
+	require.Equal(t, 2, r.Cap())
+	require.NoError(t, r.GrowBy(2))
+	require.Equal(t, 4, r.Cap())
+	require.True(t, r.PushBack(4))
+	require.True(t, r.PushBack(5))
+	require.False(t, r.PushBack(6))
+	require.Equal(t, []int{2, 3, 4, 5}, slices.Collect(r.All()))
+}
+
+func TestRingShrink(t *testing.T) {
+	r := collections.NewRing[int](8)
+	for i := 0; i < 8; i++ {
+		r.PushBack(i)
+	}
+	for i := 0; i < 5; i++ {
+		r.PopFront()
+	}
+	r.PushBack(8) // 5,6,7,8 wrapped.
+
+	require.NoError(t, r.Resize(6))
+	require.Equal(t, 6, r.Cap())
+	require.Equal(t, []int{5, 6, 7, 8}, slices.Collect(r.All()))
+	require.Error(t, r.Resize(3))
+
+	r.ShrinkToFit()
+	require.Equal(t, 4, r.Cap())
+	require.Equal(t, []int{5, 6, 7, 8}, slices.Collect(r.All()))
+	require.False(t, r.PushBack(9))
+	el, _ := r.PopFront()
+	require.Equal(t, 5, el)
+	require.True(t, r.PushBack(9))
+	require.Equal(t, []int{6, 7, 8, 9}, slices.Collect(r.All()))
+}
+
+func TestRingTrimCapacity(t *testing.T) {
+	r := collections.NewDynamicRing[int](2)
+	for i := 0; i < 9; i++ {
+		r.PushBack(i)
+	}
+	r.Skip(6)
+	require.Equal(t, 3, r.Len())
+	require.Equal(t, 16, r.Cap())
+
+	require.False(t, r.TrimCapacity(13))
+	require.Equal(t, 16, r.Cap())
+	require.True(t, r.TrimCapacity(2))
+	require.Equal(t, 5, r.Cap())
+	require.Equal(t, []int{6, 7, 8}, slices.Collect(r.All()))
+	require.False(t, r.TrimCapacity(2))
+
+	require.True(t, r.TrimCapacity(-1))
+	require.Equal(t, 3, r.Cap())
+	require.Equal(t, []int{6, 7, 8}, slices.Collect(r.All()))
+}
+
+func TestRingSetCap(t *testing.T) {
+	r := collections.NewRing[int](4)
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(4) // 1,2,3,4 wrapped.
+
+	require.Equal(t, 0, r.SetCap(6))
+	require.Equal(t, 6, r.Cap())
+	require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(r.All()))
+	require.True(t, r.PushBack(5))
+
+	// Shrinking keeps the newest elements.
+	require.Equal(t, 3, r.SetCap(2))
+	require.Equal(t, 2, r.Cap())
+	require.Equal(t, []int{4, 5}, slices.Collect(r.All()))
+	require.False(t, r.PushBack(6))
+	el, ok := r.PopFront()
+	require.True(t, ok)
+	require.Equal(t, 4, el)
+	require.True(t, r.PushBack(6))
+	require.Equal(t, []int{5, 6}, slices.Collect(r.All()))
+
+	// A negative capacity panics before dropping any elements.
+	require.Panics(t, func() {
+		r.SetCap(-1)
+	})
+	require.Equal(t, []int{5, 6}, slices.Collect(r.All()))
+	require.Equal(t, 2, r.Cap())
+
+	require.Equal(t, 2, r.SetCap(0))
+	require.Equal(t, 0, r.Len())
+	require.Equal(t, 0, r.Cap())
+}
+
+func TestRingFold(t *testing.T) {
+	r := collections.NewRing[int](4)
+	for i := 1; i <= 4; i++ {
+		r.PushBack(i)
+	}
+	// Wrap the ring so that both halves are visited.
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(5)
+
+	sum := collections.Fold(r, 0, func(acc, v int) (int, bool) {
+		return acc + v, true
+	})
+	require.Equal(t, 3+4+5, sum)
+
+	// Stop once the threshold is reached.
+	partial := collections.Fold(r, 0, func(acc, v int) (int, bool) {
+		acc += v
+		return acc, acc < 7
+	})
+	require.Equal(t, 7, partial)
+
+	empty := collections.NewRing[int](2)
+	require.Equal(t, 42, collections.Fold(empty, 42, func(acc, v int) (int, bool) {
+		return acc + v, true
+	}))
+}
+func TestRingReduce(t *testing.T) {
+	r := collections.NewRing[int](4)
+	for i := 1; i <= 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(5) // 2,3,4,5 wrapped.
+
+	sum := func(acc, v int) int { return acc + v }
+	require.Equal(t, 2+3+4+5, collections.RingReduce(r, 0, sum))
+	require.Equal(t, "2345", collections.RingReduce(r, "", func(acc string, v int) string {
+		return acc + strconv.Itoa(v)
+	}))
+	require.Equal(t, 42, collections.RingReduce(collections.NewRing[int](2), 42, sum))
+	require.Zero(t, testing.AllocsPerRun(10, func() {
+		collections.RingReduce(r, 0, sum)
+	}))
+}
+
+func TestRingMap(t *testing.T) {
+	r := collections.NewRing[int](4)
+	for i := 1; i <= 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PushBack(5) // 2,3,4,5 wrapped.
+
+	mapped := collections.RingMap(r, strconv.Itoa)
+	require.Equal(t, 4, mapped.Cap())
+	require.Equal(t, []string{"2", "3", "4", "5"}, slices.Collect(mapped.All()))
+	require.Equal(t, []int{2, 3, 4, 5}, slices.Collect(r.All()))
+
+	empty := collections.RingMap(collections.NewRing[int](3), strconv.Itoa)
+	require.Equal(t, 0, empty.Len())
+	require.Equal(t, 3, empty.Cap())
+}
+
+func TestRingConcat(t *testing.T) {
+	a := collections.NewRing[int](3)
+	for i := 0; i < 3; i++ {
+		a.PushBack(i)
+	}
+	a.PopFront()
+	a.PushBack(3) // 1,2,3 wrapped.
+	b := collections.RingFromSlice([]int{4, 5}, 2)
+
+	c := collections.RingConcat(a, b)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(c.All()))
+	require.Equal(t, 5, c.Cap())
+	require.Equal(t, []int{1, 2, 3}, slices.Collect(a.All()))
+	require.Equal(t, []int{4, 5}, slices.Collect(b.All()))
+
+	empty := collections.NewRing[int](2)
+	c = collections.RingConcat(empty, empty)
+	require.Equal(t, 0, c.Len())
+	require.Equal(t, 0, c.Cap())
+}
+
+func TestRingMinMax(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	_, _, ok := collections.RingMinMax(collections.NewRing[int](3), less)
+	require.False(t, ok)
+
+	r := collections.NewRing[int](4)
+	for _, v := range []int{5, 3, 8, 1} {
+		r.PushBack(v)
+	}
+	r.PopFront()
+	r.PushBack(9) // 3,8,1,9 wrapped.
+
+	lo, hi, ok := collections.RingMinMax(r, less)
+	require.True(t, ok)
+	require.Equal(t, 1, lo)
+	require.Equal(t, 9, hi)
 }
 
 func BenchmarkRing(b *testing.B) {
@@ -181,6 +1565,53 @@ func BenchmarkRing(b *testing.B) {
 	}
 }
 
+func BenchmarkRingReset(b *testing.B) {
+	for _, bc := range []struct {
+		name  string
+		reset func(*collections.Ring[int])
+	}{
+		{"Reset", (*collections.Ring[int]).Reset},
+		{"ResetNoZero", (*collections.Ring[int]).ResetNoZero},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			r := collections.NewRing[int](1 << 16)
+			for i := 0; i < b.N; i++ {
+				r.PushBack(i)
+				bc.reset(r)
+			}
+		})
+	}
+}
+
+func BenchmarkRingScan(b *testing.B) {
+	for _, bc := range []struct {
+		name    string
+		newRing func(int) *collections.Ring[int]
+	}{
+		{"Default", collections.NewRing[int]},
+		{"Compacting", collections.NewRingCompacting[int]},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			r := bc.newRing(1024)
+			for i := 0; i < 1024; i++ {
+				r.PushBack(i)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// Steady push/pop churn, then scan the whole ring.
+				for j := 0; j < 16; j++ {
+					v, _ := r.PopFront()
+					r.PushBack(v)
+				}
+				r.Scan(func(v int) bool {
+					return v < 0
+				})
+			}
+		})
+	}
+}
+
 // fakeRing is a simplified implementation of a buffer used for fuzzing tests.
 // This behaves like a ring buffer, but it's not optimized for performance.
 type fakeRing struct {
@@ -195,6 +1626,16 @@ func (r *fakeRing) PushBack(e int) bool {
 	return true
 }
 
+func (r *fakeRing) PushFront(e int) bool {
+	if len(r.elements) == cap(r.elements) {
+		return false
+	}
+	r.elements = append(r.elements, 0)
+	copy(r.elements[1:], r.elements)
+	r.elements[0] = e
+	return true
+}
+
 func (r *fakeRing) PopFront() (int, bool) {
 	if len(r.elements) == 0 {
 		return 0, false
@@ -205,6 +1646,15 @@ func (r *fakeRing) PopFront() (int, bool) {
 	return el, true
 }
 
+func (r *fakeRing) PopBack() (int, bool) {
+	if len(r.elements) == 0 {
+		return 0, false
+	}
+	el := r.elements[len(r.elements)-1]
+	r.elements = r.elements[:len(r.elements)-1]
+	return el, true
+}
+
 func (r *fakeRing) Copy(out []int) int {
 	return copy(out, r.elements)
 }
@@ -214,6 +1664,9 @@ func (r *fakeRing) Len() int {
 }
 
 func (r *fakeRing) PopIndex(i int) (int, bool) {
+	if i < 0 {
+		i += len(r.elements)
+	}
 	if i < 0 || i >= len(r.elements) {
 		return 0, false
 	}
@@ -222,7 +1675,37 @@ func (r *fakeRing) PopIndex(i int) (int, bool) {
 	return el, true
 }
 
+func (r *fakeRing) Insert(i int, e int) bool {
+	if i < 0 || i > len(r.elements) || len(r.elements) == cap(r.elements) {
+		return false
+	}
+	r.elements = slices.Insert(r.elements, i, e)
+	return true
+}
+
+func (r *fakeRing) Reverse() {
+	slices.Reverse(r.elements)
+}
+
+func (r *fakeRing) Rotate(n int) {
+	if len(r.elements) == 0 {
+		return
+	}
+	k := (n%len(r.elements) + len(r.elements)) % len(r.elements)
+	rotated := append(slices.Clone(r.elements[k:]), r.elements[:k]...)
+	copy(r.elements, rotated)
+}
+
+func (r *fakeRing) RemoveFunc(pred func(int) bool) int {
+	n := len(r.elements)
+	r.elements = slices.DeleteFunc(r.elements, pred)
+	return n - len(r.elements)
+}
+
 func (r *fakeRing) PeekIndex(idx int) (int, bool) {
+	if idx < 0 {
+		idx += len(r.elements)
+	}
 	if idx < 0 || idx >= len(r.elements) {
 		return 0, false
 	}
@@ -237,6 +1720,12 @@ const (
 	popIndex
 	peekIndex
 	scan
+	pushFront
+	popBack
+	insert
+	reverse
+	rotate
+	removeFunc
 	lastOpForCounting // keep last
 )
 
@@ -278,6 +1767,18 @@ func FuzzRing(f *testing.F) {
 				if ok1 != ok2 {
 					t.Fatalf("pushBack differs: %v vs %v in %v vs %v", ok1, ok2, fake, real)
 				}
+			case pushFront:
+				var value int
+				if i+1 < len(ops) {
+					value = int(ops[i+1])
+					i++
+				}
+				t.Logf("pushFront %d", value)
+				ok1 := fake.PushFront(value)
+				ok2 := real.PushFront(value)
+				if ok1 != ok2 {
+					t.Fatalf("pushFront differs: %v vs %v in %v vs %v", ok1, ok2, fake, real)
+				}
 			case popFront:
 				t.Logf("popFront")
 				f1, ok1 := fake.PopFront()
@@ -285,12 +1786,61 @@ func FuzzRing(f *testing.F) {
 				if f1 != r1 || ok1 != ok2 {
 					t.Fatalf("popFront differs: %v vs %v in %v vs %v", f1, r1, fake, real)
 				}
-			case popIndex:
+			case popBack:
+				t.Logf("popBack")
+				f1, ok1 := fake.PopBack()
+				r1, ok2 := real.PopBack()
+				if f1 != r1 || ok1 != ok2 {
+					t.Fatalf("popBack differs: %v vs %v in %v vs %v", f1, r1, fake, real)
+				}
+			case insert:
 				var idx int
 				if i+1 < len(ops) {
 					idx = int(ops[i+1])
 					i++
 				}
+				value := 1000 + i
+				t.Logf("insert %d %d", idx, value)
+				ok1 := fake.Insert(idx, value)
+				ok2 := real.Insert(idx, value)
+				if ok1 != ok2 {
+					t.Fatalf("insert differs: %v vs %v in %v vs %v", ok1, ok2, fake, real)
+				}
+			case reverse:
+				t.Logf("reverse")
+				fake.Reverse()
+				real.Reverse()
+				if !slices.Equal(fake.elements, slices.Collect(real.All())) {
+					t.Fatalf("reverse differs: %v vs %v", fake, real)
+				}
+			case rotate:
+				var n int
+				if i+1 < len(ops) {
+					n = int(ops[i+1]) - 8
+					i++
+				}
+				t.Logf("rotate %d", n)
+				fake.Rotate(n)
+				real.Rotate(n)
+			case removeFunc:
+				mod := 2
+				if i+1 < len(ops) {
+					mod += int(uint(ops[i+1]) % 4)
+					i++
+				}
+				t.Logf("removeFunc %d", mod)
+				pred := func(v int) bool { return v%mod == 0 }
+				n1 := fake.RemoveFunc(pred)
+				n2 := real.RemoveFunc(pred)
+				if n1 != n2 {
+					t.Fatalf("removeFunc differs: %v vs %v in %v vs %v", n1, n2, fake, real)
+				}
+			case popIndex:
+				var idx int
+				if i+1 < len(ops) {
+					idx = int(ops[i+1]) - 8
+					i++
+				}
 				t.Logf("popIndex %d", idx)
 				f1, ok1 := fake.PopIndex(idx)
 				r1, ok2 := real.PopIndex(idx)
@@ -300,7 +1850,7 @@ func FuzzRing(f *testing.F) {
 			case peekIndex:
 				var idx int
 				if i+1 < len(ops) {
-					idx = int(ops[i+1])
+					idx = int(ops[i+1]) - 8
 					i++
 				}
 				t.Logf("peekIndex %d", idx)
@@ -309,6 +1859,11 @@ func FuzzRing(f *testing.F) {
 				if f1 != r1 || ok1 != ok2 {
 					t.Fatalf("peekIndex differs: %v vs %v in %v vs %v", f1, r1, fake, real)
 				}
+				b1, ok1 := fake.PeekIndex(fake.Len() - 1)
+				b2, ok2 := real.PeekBack()
+				if b1 != b2 || ok1 != ok2 {
+					t.Fatalf("peekBack differs: %v vs %v in %v vs %v", b1, b2, fake, real)
+				}
 			case scan:
 				var idx int
 				if i+1 < len(ops) {
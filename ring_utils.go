@@ -0,0 +1,100 @@
+package collections
+
+// Fold calls fn for each element in the ring, in order, threading an
+// accumulator through the calls. The function returns the new accumulator and
+// whether to continue. Folding stops early when fn returns false, and the
+// accumulator returned by that call is the result.
+//
+// This is a package function, rather than a method, because it introduces the
+// accumulator type parameter A.
+func Fold[T, A any](r *Ring[T], init A, fn func(A, T) (A, bool)) A {
+	acc := init
+	for _, e := range r.right {
+		var ok bool
+		if acc, ok = fn(acc, e); !ok {
+			return acc
+		}
+	}
+	for _, e := range r.left {
+		var ok bool
+		if acc, ok = fn(acc, e); !ok {
+			return acc
+		}
+	}
+	return acc
+}
+
+// RingReduce calls fn for each element in the ring, in order, threading an
+// accumulator through the calls, and returns the final accumulator. It is like
+// Fold, but always visits every element.
+func RingReduce[T, A any](r *Ring[T], init A, fn func(A, T) A) A {
+	return Fold(r, init, func(acc A, e T) (A, bool) {
+		return fn(acc, e), true
+	})
+}
+
+// RingMap returns a new ring with the same capacity as r, holding the result
+// of calling fn on each element of r, in order. The new ring grows or compacts
+// like r, if it was created with NewDynamicRing or NewRingCompacting. The
+// source ring is not modified.
+func RingMap[T, U any](r *Ring[T], fn func(T) U) *Ring[U] {
+	out := NewRing[U](r.Cap())
+	out.compact, out.dynamic = r.compact, r.dynamic
+	for e := range r.All() {
+		out.PushBack(fn(e))
+	}
+	return out
+}
+
+// RingConcat returns a new ring holding the elements of a followed by the
+// elements of b, in order. The new ring is full, with a capacity equal to the
+// combined length. Neither input ring is modified.
+func RingConcat[T any](a, b *Ring[T]) *Ring[T] {
+	out := NewRing[T](a.Len() + b.Len())
+	n := a.Copy(out.elements)
+	b.Copy(out.elements[n:])
+	out.right = out.elements
+	return out
+}
+
+// RingMinMax returns the smallest and largest elements of the ring, according
+// to less, in a single pass. If several elements are equally small or large,
+// the first of them is returned. If the ring is empty, it returns false.
+func RingMinMax[T any](r *Ring[T], less func(a, b T) bool) (lo, hi T, ok bool) {
+	lo, ok = r.PeekFront()
+	if !ok {
+		return lo, lo, false
+	}
+	hi = lo
+	for e := range r.All() {
+		if less(e, lo) {
+			lo = e
+		}
+		if less(hi, e) {
+			hi = e
+		}
+	}
+	return lo, hi, true
+}
+
+// EqualSlice returns whether the elements of the ring are equal to the
+// elements of the slice, in order.
+func EqualSlice[T comparable](r *Ring[T], s []T) bool {
+	return r.EqualSlice(s, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// IndexOf returns the index of the first element in the ring which is equal to
+// target, or -1 if there is no match.
+func IndexOf[T comparable](r *Ring[T], target T) int {
+	_, idx := r.Scan(func(v T) bool {
+		return v == target
+	})
+	return idx
+}
+
+// Contains returns whether the ring holds an element equal to target.
+func Contains[T comparable](r *Ring[T], target T) bool {
+	return IndexOf(r, target) >= 0
+}
@@ -0,0 +1,95 @@
+package collections
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Semaphore is a weighted semaphore, which limits the total weight of
+// concurrent acquisitions to a fixed capacity.
+//
+// Waiters are not queued in order, so a large acquisition may wait while
+// smaller acquisitions succeed.
+type Semaphore struct {
+	mu       sync.Mutex
+	capacity int
+	used     int
+	released chan struct{} // closed when weight is released.
+}
+
+// NewSemaphore creates a new Semaphore with the given capacity.
+func NewSemaphore(capacity int) *Semaphore {
+	return &Semaphore{capacity: capacity}
+}
+
+// Acquire blocks until n can be acquired, or the context is cancelled.
+// If n is negative, or larger than the capacity, it returns an error
+// immediately, since it could never be acquired.
+func (s *Semaphore) Acquire(ctx context.Context, n int) error {
+	if n < 0 {
+		return fmt.Errorf("cannot acquire negative weight %d", n)
+	}
+	if n > s.capacity {
+		return fmt.Errorf("cannot acquire %d from a semaphore with capacity %d", n, s.capacity)
+	}
+	for {
+		ch, ok := s.tryAcquire(n)
+		if ok {
+			return nil
+		}
+
+		// Wait for weight to be released.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ch:
+		}
+	}
+}
+
+// TryAcquire acquires n without blocking. It returns false if n is not
+// currently available, or if n is negative.
+func (s *Semaphore) TryAcquire(n int) bool {
+	if n < 0 {
+		return false
+	}
+	_, ok := s.tryAcquire(n)
+	return ok
+}
+
+// tryAcquire acquires n if it is available. Otherwise, it returns a channel
+// which will unblock when weight is released.
+func (s *Semaphore) tryAcquire(n int) (<-chan struct{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.used+n <= s.capacity {
+		s.used += n
+		return nil, true
+	}
+	if s.released == nil {
+		s.released = make(chan struct{})
+	}
+	return s.released, false
+}
+
+// Release returns n to the semaphore, and unblocks any waiters.
+// It panics if n is negative, or if more is released than has been acquired.
+func (s *Semaphore) Release(n int) {
+	if n < 0 {
+		panic("collections: semaphore released negative weight")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if n > s.used {
+		panic("collections: semaphore released more than acquired")
+	}
+	s.used -= n
+	if s.released != nil {
+		close(s.released)
+		s.released = nil
+	}
+}
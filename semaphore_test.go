@@ -0,0 +1,102 @@
+package collections_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arg0net/collections"
+)
+
+func TestSemaphore(t *testing.T) {
+	s := collections.NewSemaphore(3)
+	ctx := context.Background()
+
+	require.NoError(t, s.Acquire(ctx, 2))
+	require.True(t, s.TryAcquire(1))
+	require.False(t, s.TryAcquire(1))
+	require.Error(t, s.Acquire(ctx, 4))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Acquire(ctx, 2)
+	}()
+
+	// give time for acquire to start.
+	time.Sleep(10 * time.Millisecond)
+	require.Empty(t, done)
+	s.Release(1)
+	require.Empty(t, done)
+	s.Release(1)
+	require.NoError(t, <-done)
+
+	require.Panics(t, func() {
+		s.Release(5)
+	})
+}
+
+func TestSemaphoreNegative(t *testing.T) {
+	s := collections.NewSemaphore(2)
+	require.Error(t, s.Acquire(context.Background(), -1))
+	require.False(t, s.TryAcquire(-5))
+
+	// The capacity bound still holds.
+	require.True(t, s.TryAcquire(2))
+	require.False(t, s.TryAcquire(1))
+	require.Panics(t, func() {
+		s.Release(-1)
+	})
+	require.False(t, s.TryAcquire(1))
+}
+
+func TestSemaphoreCancel(t *testing.T) {
+	s := collections.NewSemaphore(1)
+	require.True(t, s.TryAcquire(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() {
+		result <- s.Acquire(ctx, 1)
+	}()
+
+	// give time for acquire to start.
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-result, context.Canceled)
+}
+
+func TestSemaphoreConcurrency(t *testing.T) {
+	s := collections.NewSemaphore(3)
+	ctx := context.Background()
+
+	const workers = 20
+	var active, peak atomic.Int32
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			if err := s.Acquire(ctx, 1); err != nil {
+				errs <- err
+				return
+			}
+			n := active.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			active.Add(-1)
+			s.Release(1)
+			errs <- nil
+		}()
+	}
+	for i := 0; i < workers; i++ {
+		require.NoError(t, <-errs)
+	}
+	require.LessOrEqual(t, peak.Load(), int32(3))
+	require.True(t, s.TryAcquire(3))
+}